@@ -0,0 +1,3 @@
+data "forgejo_organization_actions_secrets" "main" {
+  organization_name = "example"
+}
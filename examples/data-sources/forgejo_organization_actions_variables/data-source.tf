@@ -0,0 +1,3 @@
+data "forgejo_organization_actions_variables" "main" {
+  organization_name = "example"
+}
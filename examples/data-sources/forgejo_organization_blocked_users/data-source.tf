@@ -0,0 +1,3 @@
+data "forgejo_organization_blocked_users" "main" {
+  organization_name = "example"
+}
@@ -0,0 +1,3 @@
+data "forgejo_organization_labels" "main" {
+  organization_name = "test"
+}
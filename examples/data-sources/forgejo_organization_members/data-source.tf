@@ -0,0 +1,8 @@
+data "forgejo_organization_members" "all" {
+  organization_name = "example"
+}
+
+data "forgejo_organization_members" "public" {
+  organization_name = "example"
+  public_only       = true
+}
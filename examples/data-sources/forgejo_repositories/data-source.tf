@@ -1 +1,7 @@
 data "forgejo_repositories" "example" {}
+
+data "forgejo_repositories" "mirrors" {
+  mode  = "mirror"
+  order = "asc"
+  sort  = "alpha"
+}
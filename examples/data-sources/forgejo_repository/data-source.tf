@@ -0,0 +1,4 @@
+data "forgejo_repository" "main" {
+  name       = "infrastructure"
+  owner_name = "adyxax"
+}
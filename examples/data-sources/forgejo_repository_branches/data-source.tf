@@ -0,0 +1,4 @@
+data "forgejo_repository_branches" "main" {
+  owner      = "adyxax"
+  repository = "infrastructure"
+}
@@ -0,0 +1,4 @@
+data "forgejo_team" "main" {
+  name              = "owners"
+  organization_name = "test"
+}
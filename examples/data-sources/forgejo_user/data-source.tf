@@ -0,0 +1,7 @@
+data "forgejo_user" "by_login" {
+  login = "bot"
+}
+
+data "forgejo_user" "by_id" {
+  id = 42
+}
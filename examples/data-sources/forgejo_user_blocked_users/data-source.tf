@@ -0,0 +1 @@
+data "forgejo_user_blocked_users" "main" {}
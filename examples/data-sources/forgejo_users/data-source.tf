@@ -1 +1,5 @@
 data "forgejo_users" "example" {}
+
+data "forgejo_users" "bots" {
+  query = "bot"
+}
@@ -0,0 +1,12 @@
+ephemeral "forgejo_access_token" "bot" {
+  name     = "terraform"
+  password = var.bot_password
+  scopes   = ["write:repository"]
+  username = "bot"
+}
+
+provider "forgejo" {
+  alias     = "bot"
+  api_token = ephemeral.forgejo_access_token.bot.token
+  base_uri  = "https://git.adyxax.org/"
+}
@@ -0,0 +1,6 @@
+resource "forgejo_access_token" "renovate" {
+  name        = "renovate"
+  password_wo = var.bot_password
+  scopes      = ["read:user", "write:issue", "write:repository"]
+  username    = "bot"
+}
@@ -1,3 +1,8 @@
 resource "forgejo_organization" "main" {
   name = "test"
 }
+
+resource "forgejo_organization" "with_avatar" {
+  avatar_file = "${path.module}/avatar.png"
+  name        = "test-avatar"
+}
@@ -0,0 +1 @@
+terraform import forgejo_organization_actions_secret.main <organization_name>/<secret_name>
@@ -0,0 +1,5 @@
+resource "forgejo_organization_actions_secret" "main" {
+  data              = "secret"
+  name              = "TEST"
+  organization_name = "example"
+}
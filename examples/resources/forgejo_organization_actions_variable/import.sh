@@ -0,0 +1 @@
+terraform import forgejo_organization_actions_variable.main <organization_name>/<variable_name>
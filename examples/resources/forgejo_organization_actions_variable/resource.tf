@@ -0,0 +1,5 @@
+resource "forgejo_organization_actions_variable" "main" {
+  data              = "value"
+  name              = "TEST"
+  organization_name = "example"
+}
@@ -0,0 +1 @@
+terraform import forgejo_organization_blocked_user.main <organization>/<username>
@@ -0,0 +1,4 @@
+resource "forgejo_organization_blocked_user" "main" {
+  organization_name = "example"
+  username          = "spammer"
+}
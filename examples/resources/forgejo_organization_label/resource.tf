@@ -0,0 +1,7 @@
+resource "forgejo_organization_label" "priority_high" {
+  color             = "e11d21"
+  description       = "High priority."
+  exclusive         = true
+  name              = "priority/high"
+  organization_name = "test"
+}
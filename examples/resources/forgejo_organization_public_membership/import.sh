@@ -0,0 +1 @@
+terraform import forgejo_organization_public_membership.main <organization>/<username>
@@ -0,0 +1,3 @@
+resource "forgejo_organization_public_membership" "main" {
+  organization_name = "example"
+}
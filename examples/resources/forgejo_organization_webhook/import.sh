@@ -0,0 +1 @@
+terraform import forgejo_organization_webhook.main <organization_name>/<webhook_id>
@@ -0,0 +1,10 @@
+resource "forgejo_organization_webhook" "main" {
+  config = {
+    content_type = "json"
+    url          = "https://ci.example.com/hooks/forgejo"
+  }
+  events            = ["push", "release"]
+  organization_name = "example"
+  secret            = var.webhook_secret
+  type              = "forgejo"
+}
@@ -10,3 +10,8 @@ resource "forgejo_repository" "organization_example" {
   owner       = "adyxax.org"
   private     = false
 }
+
+resource "forgejo_repository" "avatar_example" {
+  avatar = filebase64("${path.module}/avatar.png")
+  name   = "test-avatar"
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_actions_secret.main <owner>/<repository_name>/<secret_name>
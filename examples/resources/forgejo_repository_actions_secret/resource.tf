@@ -4,3 +4,12 @@ resource "forgejo_repository_actions_secret" "main" {
   owner      = "adyxax"
   repository = "example"
 }
+
+# With terraform 1.11 or later, the secret can be kept out of the state.
+resource "forgejo_repository_actions_secret" "write_only" {
+  data_wo         = "secret"
+  data_wo_version = 1
+  name            = "TEST_WRITE_ONLY"
+  owner           = "adyxax"
+  repository      = "example"
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_branch.main <owner>/<repository>/<branch>
@@ -0,0 +1,12 @@
+resource "forgejo_repository_branch" "main" {
+  name       = "develop"
+  owner      = "adyxax"
+  repository = "infrastructure"
+}
+
+resource "forgejo_repository_branch" "from_tag" {
+  name         = "hotfix-1.0"
+  old_ref_name = "v1.0.0"
+  owner        = "adyxax"
+  repository   = "infrastructure"
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_deploy_key.main <owner>/<repository_name>/<deploy_key_id>
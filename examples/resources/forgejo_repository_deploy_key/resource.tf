@@ -0,0 +1,7 @@
+resource "forgejo_repository_deploy_key" "main" {
+  key        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGZG0whN6qwz8UYCuSAG9SJbz0Sm/OWLbqZnHRuRI6mg deploy@example"
+  owner      = "adyxax"
+  read_only  = true
+  repository = "example"
+  title      = "deploy"
+}
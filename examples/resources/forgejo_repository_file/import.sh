@@ -0,0 +1,2 @@
+terraform import forgejo_repository_file.codeowners <owner>/<repository>/<path>
+terraform import forgejo_repository_file.ci <owner>/<repository>:<branch>/<path>
@@ -0,0 +1,18 @@
+resource "forgejo_repository_file" "codeowners" {
+  commit_message = "Manage CODEOWNERS with terraform"
+  content        = "* @adyxax\n"
+  owner          = "adyxax"
+  path           = "CODEOWNERS"
+  repository     = "infrastructure"
+}
+
+resource "forgejo_repository_file" "ci" {
+  author_email        = "bot@example.com"
+  author_name         = "bot"
+  branch              = "main"
+  content             = file("${path.module}/ci.yml")
+  overwrite_on_create = true
+  owner               = "adyxax"
+  path                = ".forgejo/workflows/ci.yml"
+  repository          = "infrastructure"
+}
@@ -0,0 +1,11 @@
+resource "forgejo_repository_files" "workflows" {
+  branch         = "main"
+  commit_message = "Update CI workflows"
+  files = {
+    ".forgejo/workflows/build.yml"   = file("${path.module}/workflows/build.yml")
+    ".forgejo/workflows/release.yml" = file("${path.module}/workflows/release.yml")
+  }
+  new_branch = "ci-workflows"
+  owner      = "adyxax"
+  repository = "infrastructure"
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_label.main <owner>/<repository_name>/<label_name_or_id>
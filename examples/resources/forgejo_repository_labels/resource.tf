@@ -0,0 +1,16 @@
+resource "forgejo_repository_labels" "main" {
+  authoritative = true
+  owner         = "adyxax"
+  repository    = "example"
+  labels = {
+    "kind/bug" = {
+      color       = "ee0701"
+      description = "Something is not working."
+      exclusive   = true
+    }
+    "kind/feature" = {
+      color     = "0288d1"
+      exclusive = true
+    }
+  }
+}
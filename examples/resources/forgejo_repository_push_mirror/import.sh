@@ -0,0 +1 @@
+terraform import forgejo_repository_push_mirror.main <owner>/<repository_name>/<remote_name>
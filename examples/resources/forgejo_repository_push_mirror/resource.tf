@@ -5,3 +5,13 @@ resource "forgejo_repository_push_mirror" "main" {
   remote_username = "adyxax"
   repository      = "example"
 }
+
+# With terraform 1.11 or later, the password can be kept out of the state.
+resource "forgejo_repository_push_mirror" "write_only" {
+  owner                      = "adyxax"
+  remote_address             = "https://github.com/adyxax/tfstated"
+  remote_password_wo         = "secret"
+  remote_password_wo_version = 1
+  remote_username            = "adyxax"
+  repository                 = "example"
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_release.main <owner>/<repository>/<release_id>
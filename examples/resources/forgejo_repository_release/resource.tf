@@ -0,0 +1,8 @@
+resource "forgejo_repository_release" "main" {
+  body             = "First stable release."
+  name             = "1.0.0"
+  owner            = "adyxax"
+  repository       = "infrastructure"
+  tag_name         = "v1.0.0"
+  target_commitish = "main"
+}
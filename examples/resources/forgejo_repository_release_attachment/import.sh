@@ -0,0 +1 @@
+terraform import forgejo_repository_release_attachment.main <owner>/<repository>/<release_id>/<attachment_id>
@@ -0,0 +1,6 @@
+resource "forgejo_repository_release_attachment" "main" {
+  file       = "${path.module}/dist/infrastructure.tar.gz"
+  owner      = forgejo_repository_release.main.owner
+  release_id = forgejo_repository_release.main.id
+  repository = forgejo_repository_release.main.repository
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_tag.lightweight <owner>/<repository>/<tag>
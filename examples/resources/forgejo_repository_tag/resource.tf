@@ -0,0 +1,13 @@
+resource "forgejo_repository_tag" "lightweight" {
+  name       = "v1.0.0"
+  owner      = "adyxax"
+  repository = "infrastructure"
+}
+
+resource "forgejo_repository_tag" "annotated" {
+  message    = "Release 1.1.0"
+  name       = "v1.1.0"
+  owner      = "adyxax"
+  repository = "infrastructure"
+  target     = "main"
+}
@@ -0,0 +1 @@
+terraform import forgejo_repository_webhook.main <owner>/<repository_name>/<webhook_id>
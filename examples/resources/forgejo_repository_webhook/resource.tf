@@ -0,0 +1,12 @@
+resource "forgejo_repository_webhook" "main" {
+  branch_filter = "main"
+  config = {
+    content_type = "json"
+    url          = "https://ci.example.com/hooks/forgejo"
+  }
+  events     = ["pull_request", "push"]
+  owner      = "adyxax"
+  repository = "example"
+  secret     = var.webhook_secret
+  type       = "forgejo"
+}
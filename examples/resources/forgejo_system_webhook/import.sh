@@ -0,0 +1 @@
+terraform import forgejo_system_webhook.main <webhook_id>
@@ -0,0 +1,19 @@
+resource "forgejo_system_webhook" "main" {
+  config = {
+    content_type = "json"
+    url          = "https://audit.example.com/hooks/forgejo"
+  }
+  events = ["create", "delete", "repository"]
+  secret = var.webhook_secret
+  type   = "forgejo"
+}
+
+resource "forgejo_system_webhook" "default" {
+  config = {
+    content_type = "json"
+    url          = "https://ci.example.com/hooks/forgejo"
+  }
+  events            = ["push"]
+  is_system_webhook = false
+  type              = "forgejo"
+}
@@ -1 +1,2 @@
 terraform import forgejo_team.main <team_id>
+terraform import forgejo_team.main <organization_name>/<team_name>
@@ -3,3 +3,15 @@ resource "forgejo_team" "main" {
   organization_name = "test"
   permission        = "read"
 }
+
+resource "forgejo_team" "qa" {
+  name              = "qa"
+  organization_name = "test"
+  permission        = "write"
+  units_map = {
+    "repo.actions" = "none"
+    "repo.code"    = "read"
+    "repo.issues"  = "write"
+    "repo.pulls"   = "read"
+  }
+}
@@ -0,0 +1 @@
+terraform import forgejo_team_repository.main <organization_name>/<team_name>/<repository_name>
@@ -0,0 +1,11 @@
+resource "forgejo_team" "main" {
+  name              = "test"
+  organization_name = "test"
+  permission        = "write"
+}
+
+resource "forgejo_team_repository" "main" {
+  organization_name = "test"
+  repository        = "example"
+  team_id           = forgejo_team.main.id
+}
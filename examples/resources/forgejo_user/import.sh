@@ -0,0 +1 @@
+terraform import forgejo_user.main <login>
@@ -0,0 +1,6 @@
+resource "forgejo_user" "main" {
+  email     = "bot@example.com"
+  full_name = "Automation bot"
+  login     = "bot"
+  password  = "changeme"
+}
@@ -0,0 +1,4 @@
+# Forgejo does not allow listing user secrets, so the import cannot check that
+# the secret exists: double check its name, otherwise the next apply creates a
+# new secret instead of managing the existing one.
+terraform import forgejo_user_actions_secret.main <secret_name>
@@ -0,0 +1,4 @@
+resource "forgejo_user_actions_secret" "main" {
+  data = "secret"
+  name = "TEST"
+}
@@ -0,0 +1 @@
+terraform import forgejo_user_actions_variable.main <variable_name>
@@ -0,0 +1,4 @@
+resource "forgejo_user_actions_variable" "main" {
+  data = "value"
+  name = "TEST"
+}
@@ -0,0 +1 @@
+terraform import forgejo_user_blocked_user.main <username>
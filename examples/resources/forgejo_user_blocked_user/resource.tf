@@ -0,0 +1,3 @@
+resource "forgejo_user_blocked_user" "main" {
+  username = "spammer"
+}
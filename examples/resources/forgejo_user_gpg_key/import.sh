@@ -0,0 +1 @@
+terraform import forgejo_user_gpg_key.main <gpg_key_id>
@@ -0,0 +1,3 @@
+resource "forgejo_user_gpg_key" "main" {
+  armored_public_key = file("${path.module}/bot.asc")
+}
@@ -0,0 +1,2 @@
+terraform import forgejo_user_ssh_key.main <ssh_key_id>
+terraform import forgejo_user_ssh_key.other <username>/<ssh_key_id>
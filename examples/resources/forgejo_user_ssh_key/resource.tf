@@ -0,0 +1,11 @@
+resource "forgejo_user_ssh_key" "main" {
+  key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGZG0whN6qwz8UYCuSAG9SJbz0Sm/OWLbqZnHRuRI6mg bot@example"
+  title = "bot"
+}
+
+# Managing the keys of another user requires an admin token.
+resource "forgejo_user_ssh_key" "other" {
+  key      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGZG0whN6qwz8UYCuSAG9SJbz0Sm/OWLbqZnHRuRI6mg other@example"
+  title    = "other"
+  username = "other"
+}
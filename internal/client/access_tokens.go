@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+type AccessToken struct {
+	Id             int64    `json:"id"`
+	Name           string   `json:"name"`
+	Scopes         []string `json:"scopes"`
+	Sha1           string   `json:"sha1"`
+	TokenLastEight string   `json:"token_last_eight"`
+}
+
+func (c *Client) AccessTokenCreate(ctx context.Context, username string, name string, scopes []string) (*AccessToken, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/users", username, "tokens")}
+	type Payload struct {
+		Name   string   `json:"name"`
+		Scopes []string `json:"scopes"`
+	}
+	payload := Payload{
+		Name:   name,
+		Scopes: scopes,
+	}
+	var response AccessToken
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create access token: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) AccessTokenDelete(ctx context.Context, username string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/users", username, "tokens", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete access token: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) AccessTokensList(ctx context.Context, username string) ([]AccessToken, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/users", username, "tokens")}
+	var response []AccessToken
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list access tokens: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+type AdminUserCreateRequest struct {
+	Email              string `json:"email"`
+	FullName           string `json:"full_name"`
+	LoginName          string `json:"login_name,omitempty"`
+	MustChangePassword *bool  `json:"must_change_password,omitempty"`
+	Password           string `json:"password,omitempty"`
+	Restricted         bool   `json:"restricted"`
+	SendNotify         bool   `json:"send_notify"`
+	SourceId           int64  `json:"source_id"`
+	Username           string `json:"username"`
+	Visibility         string `json:"visibility"`
+}
+
+type AdminUserUpdateRequest struct {
+	Active             bool   `json:"active"`
+	Admin              bool   `json:"admin"`
+	Email              string `json:"email"`
+	FullName           string `json:"full_name"`
+	LoginName          string `json:"login_name"`
+	MaxRepoCreation    *int64 `json:"max_repo_creation,omitempty"`
+	MustChangePassword *bool  `json:"must_change_password,omitempty"`
+	Password           string `json:"password,omitempty"`
+	ProhibitLogin      bool   `json:"prohibit_login"`
+	Restricted         bool   `json:"restricted"`
+	SourceId           int64  `json:"source_id"`
+	Visibility         string `json:"visibility"`
+}
+
+func (c *Client) AdminUserCreate(ctx context.Context, payload *AdminUserCreateRequest) (*User, error) {
+	uriRef := url.URL{Path: "api/v1/admin/users"}
+	var response User
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) AdminUserDelete(ctx context.Context, username string, purge bool) error {
+	uriRef := url.URL{
+		Path:     path.Join("api/v1/admin/users", username),
+		RawQuery: url.Values{"purge": {strconv.FormatBool(purge)}}.Encode(),
+	}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) AdminUserRename(ctx context.Context, username string, newUsername string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/users", username, "rename")}
+	type Payload struct {
+		NewUsername string `json:"new_username"`
+	}
+	payload := Payload{NewUsername: newUsername}
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to rename user: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) AdminUserUpdate(ctx context.Context, username string, payload *AdminUserUpdateRequest) (*User, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/users", username)}
+	var response User
+	if _, err := c.send(ctx, "PATCH", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	return &response, nil
+}
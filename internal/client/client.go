@@ -3,7 +3,9 @@ package client
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,15 +15,43 @@ import (
 )
 
 type Client struct {
+	authenticatedAdmin bool
 	authenticatedUser  string
 	baseURI            *url.URL
 	headers            *http.Header
 	httpClient         *http.Client
 	maxItemsPerPage    int
 	maxItemsPerPageStr string
+	password           string
+	uploadHttpClient   *http.Client
 }
 
-func NewClient(ctx context.Context, baseURL *url.URL, apiToken string) (*Client, error) {
+// rawPayload is a request body that send passes through as is instead of
+// encoding it as JSON. Since it can be an arbitrarily large upload, it is not
+// subject to the client timeout, but forgejo must still answer within a minute
+// once the body is sent.
+type rawPayload struct {
+	body        io.Reader
+	contentType string
+}
+
+// StatusError is returned when forgejo answers with a non 2XX status code.
+type StatusError struct {
+	Body       []byte
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("non 2XX status code received: %d, %q", e.StatusCode, e.Body)
+}
+
+// IsStatusCode reports whether forgejo answered a request with statusCode.
+func IsStatusCode(err error, statusCode int) bool {
+	var statusError *StatusError
+	return errors.As(err, &statusError) && statusError.StatusCode == statusCode
+}
+
+func NewClient(ctx context.Context, baseURL *url.URL, apiToken string, password string) (*Client, error) {
 	c := Client{
 		baseURI: baseURL,
 		headers: &http.Header{
@@ -32,6 +62,15 @@ func NewClient(ctx context.Context, baseURL *url.URL, apiToken string) (*Client,
 		httpClient: &http.Client{
 			Timeout: time.Minute,
 		},
+		password: password,
+		uploadHttpClient: &http.Client{
+			Transport: &http.Transport{
+				IdleConnTimeout:       90 * time.Second,
+				Proxy:                 http.ProxyFromEnvironment,
+				ResponseHeaderTimeout: time.Minute,
+				TLSHandshakeTimeout:   10 * time.Second,
+			},
+		},
 	}
 	settings, err := c.settingsApiGet(ctx)
 	if err != nil {
@@ -43,14 +82,34 @@ func NewClient(ctx context.Context, baseURL *url.URL, apiToken string) (*Client,
 	if err != nil {
 		return nil, fmt.Errorf("failed to get authenticated user: %s", err)
 	}
+	c.authenticatedAdmin = user.IsAdmin
 	c.authenticatedUser = user.Login
 	return &c, nil
 }
 
+func (c *Client) AuthenticatedAdmin() bool {
+	return c.authenticatedAdmin
+}
+
 func (c *Client) AuthenticatedUser() string {
 	return c.authenticatedUser
 }
 
+func (c *Client) Password() string {
+	return c.password
+}
+
+// WithBasicAuth returns a copy of the client authenticating with a username
+// and password, which forgejo requires to manage access tokens.
+func (c *Client) WithBasicAuth(username string, password string) *Client {
+	clone := *c
+	headers := c.headers.Clone()
+	credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	headers.Set("Authorization", fmt.Sprintf("Basic %s", credentials))
+	clone.headers = &headers
+	return &clone
+}
+
 func (c *Client) sendPaginated(ctx context.Context, method string, uriRef *url.URL, payload any, response any) error {
 	query, err := url.ParseQuery(uriRef.RawQuery)
 	if err != nil {
@@ -90,8 +149,14 @@ func (c *Client) sendPaginated(ctx context.Context, method string, uriRef *url.U
 func (c *Client) send(ctx context.Context, method string, uriRef *url.URL, payload any, response any) (int, error) {
 	uri := c.baseURI.ResolveReference(uriRef)
 
+	httpClient := c.httpClient
 	var payloadReader io.Reader
-	if payload != nil {
+	var contentType string
+	if raw, ok := payload.(*rawPayload); ok {
+		httpClient = c.uploadHttpClient
+		payloadReader = raw.body
+		contentType = raw.contentType
+	} else if payload != nil {
 		if body, err := json.Marshal(payload); err != nil {
 			return 0, fmt.Errorf("cannot marshal payload: %w", err)
 		} else {
@@ -104,8 +169,12 @@ func (c *Client) send(ctx context.Context, method string, uriRef *url.URL, paylo
 		return 0, fmt.Errorf("cannot create request: %w", err)
 	}
 	req.Header = *c.headers
+	if contentType != "" {
+		req.Header = c.headers.Clone()
+		req.Header.Set("Content-Type", contentType)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return 0, fmt.Errorf("cannot send request: %w", err)
 	}
@@ -115,7 +184,7 @@ func (c *Client) send(ctx context.Context, method string, uriRef *url.URL, paylo
 		return 0, fmt.Errorf("cannot read response body: %w", err)
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return 0, fmt.Errorf("non 2XX status code received: %d, %q", resp.StatusCode, body)
+		return 0, &StatusError{Body: body, StatusCode: resp.StatusCode}
 	}
 	if len(body) > 0 {
 		if err = json.Unmarshal(body, response); err != nil {
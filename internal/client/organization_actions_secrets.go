@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type OrganizationActionsSecret = RepositoryActionsSecret
+
+func (c *Client) OrganizationActionsSecretCreateOrUpdate(ctx context.Context, organizationName string, name string, data string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/secrets", name)}
+	type Payload struct {
+		Data string `json:"data"`
+	}
+	payload := Payload{Data: data}
+	if _, err := c.send(ctx, "PUT", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to create or update organization actions secret: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationActionsSecretDelete(ctx context.Context, organizationName string, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/secrets", name)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete organization actions secret: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationActionsSecretsList(ctx context.Context, organizationName string) ([]OrganizationActionsSecret, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/secrets")}
+	var response []OrganizationActionsSecret
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list organization actions secrets: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type OrganizationActionsVariable = RepositoryActionsVariable
+
+func (c *Client) OrganizationActionsVariableCreate(ctx context.Context, organizationName string, name string, value string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/variables", name)}
+	type Payload struct {
+		Value string `json:"value"`
+	}
+	payload := Payload{Value: value}
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to create organization actions variable: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationActionsVariableDelete(ctx context.Context, organizationName string, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/variables", name)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete organization actions variable: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationActionsVariableGet(ctx context.Context, organizationName string, name string) (*OrganizationActionsVariable, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/variables", name)}
+	response := OrganizationActionsVariable{}
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get organization actions variable: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) OrganizationActionsVariablesList(ctx context.Context, organizationName string) ([]OrganizationActionsVariable, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/variables")}
+	var response []OrganizationActionsVariable
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list organization actions variables: %w", err)
+	}
+	return response, nil
+}
+
+func (c *Client) OrganizationActionsVariableUpdate(ctx context.Context, organizationName string, oldName string, newName string, value string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "actions/variables", oldName)}
+	type Payload struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	payload := Payload{Name: newName, Value: value}
+	if _, err := c.send(ctx, "PUT", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to update organization actions variable: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+func (c *Client) OrganizationBlockedUserAdd(ctx context.Context, organizationName string, username string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "block", username)}
+	if _, err := c.send(ctx, "PUT", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to block user from organization: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationBlockedUserDelete(ctx context.Context, organizationName string, username string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "unblock", username)}
+	if _, err := c.send(ctx, "PUT", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to unblock user from organization: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationBlockedUsersList(ctx context.Context, organizationName string) ([]BlockedUser, error) {
+	var response []BlockedUser
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "list_blocked")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list organization blocked users: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+func (c *Client) OrganizationHookCreate(ctx context.Context, organizationName string, payload *HookCreateRequest) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "hooks")}
+	var response Hook
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create organization hook: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) OrganizationHookDelete(ctx context.Context, organizationName string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "hooks", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete organization hook: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationHookGet(ctx context.Context, organizationName string, id int64) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "hooks", strconv.FormatInt(id, 10))}
+	var response Hook
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get organization hook: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) OrganizationHookUpdate(ctx context.Context, organizationName string, id int64, payload *HookUpdateRequest) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "hooks", strconv.FormatInt(id, 10))}
+	var response Hook
+	if _, err := c.send(ctx, "PATCH", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update organization hook: %w", err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+type OrganizationLabel = RepositoryLabel
+
+type OrganizationLabelCreateRequest = RepositoryLabelCreateRequest
+
+type OrganizationLabelUpdateRequest = OrganizationLabelCreateRequest
+
+func (c *Client) OrganizationLabelCreate(ctx context.Context, organizationName string, payload *OrganizationLabelCreateRequest) (*OrganizationLabel, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "labels")}
+	response := OrganizationLabel{}
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create organization label: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) OrganizationLabelDelete(ctx context.Context, organizationName string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "labels", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete organization label: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationLabelGet(ctx context.Context, organizationName string, id int64) (*OrganizationLabel, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "labels", strconv.FormatInt(id, 10))}
+	response := OrganizationLabel{}
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get organization label: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) OrganizationLabelsList(ctx context.Context, organizationName string) ([]OrganizationLabel, error) {
+	var response []OrganizationLabel
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "labels")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list labels of organization %s: %w", organizationName, err)
+	}
+	return response, nil
+}
+
+func (c *Client) OrganizationLabelUpdate(ctx context.Context, organizationName string, id int64, payload *OrganizationLabelUpdateRequest) (*OrganizationLabel, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "labels", strconv.FormatInt(id, 10))}
+	response := OrganizationLabel{}
+	if _, err := c.send(ctx, "PATCH", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update organization label: %w", err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+func (c *Client) OrganizationMembersList(ctx context.Context, organizationName string) ([]User, error) {
+	var response []User
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "members")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	return response, nil
+}
+
+func (c *Client) OrganizationPublicMemberAdd(ctx context.Context, organizationName string, username string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "public_members", username)}
+	if _, err := c.send(ctx, "PUT", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to publicize organization membership: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationPublicMemberDelete(ctx context.Context, organizationName string, username string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "public_members", username)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to conceal organization membership: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationPublicMembersList(ctx context.Context, organizationName string) ([]User, error) {
+	var response []User
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "public_members")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list organization public members: %w", err)
+	}
+	return response, nil
+}
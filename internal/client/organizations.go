@@ -20,6 +20,10 @@ type Organization struct {
 	Website                   string `json:"website"`
 }
 
+type AvatarUpdateRequest struct {
+	Image string `json:"image"`
+}
+
 type OrganizationCreateRequest struct {
 	Description               string `json:"description,omitempty"`
 	Email                     string `json:"email,omitempty"`
@@ -41,6 +45,22 @@ type OrganizationPatchRequest struct {
 	Website                   string `json:"website,omitempty"`
 }
 
+func (c *Client) OrganizationAvatarDelete(ctx context.Context, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", name, "avatar")}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete organization avatar: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) OrganizationAvatarUpdate(ctx context.Context, name string, image string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", name, "avatar")}
+	if _, err := c.send(ctx, "POST", &uriRef, &AvatarUpdateRequest{Image: image}, nil); err != nil {
+		return fmt.Errorf("failed to update organization avatar: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) OrganizationCreate(ctx context.Context, payload *OrganizationCreateRequest) (*Organization, error) {
 	uriRef := url.URL{Path: "api/v1/orgs"}
 	response := Organization{}
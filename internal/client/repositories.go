@@ -122,6 +122,57 @@ type RepositoryUpdateRequest struct {
 	Private         bool   `json:"private"`
 }
 
+type RepositoriesSearchOptions struct {
+	Archived   *bool
+	IsTemplate *bool
+	Mode       string
+	Order      string
+	OwnerId    *int64
+	Private    *bool
+	Query      string
+	Sort       string
+	TeamId     *int64
+	Topic      *bool
+}
+
+func (o *RepositoriesSearchOptions) values() url.Values {
+	query := make(url.Values)
+	if o == nil {
+		return query
+	}
+	if o.Archived != nil {
+		query.Set("archived", strconv.FormatBool(*o.Archived))
+	}
+	if o.IsTemplate != nil {
+		query.Set("template", strconv.FormatBool(*o.IsTemplate))
+	}
+	if o.Mode != "" {
+		query.Set("mode", o.Mode)
+	}
+	if o.Order != "" {
+		query.Set("order", o.Order)
+	}
+	if o.OwnerId != nil {
+		query.Set("uid", strconv.FormatInt(*o.OwnerId, 10))
+	}
+	if o.Private != nil {
+		query.Set("is_private", strconv.FormatBool(*o.Private))
+	}
+	if o.Query != "" {
+		query.Set("q", o.Query)
+	}
+	if o.Sort != "" {
+		query.Set("sort", o.Sort)
+	}
+	if o.TeamId != nil {
+		query.Set("team_id", strconv.FormatInt(*o.TeamId, 10))
+	}
+	if o.Topic != nil {
+		query.Set("topic", strconv.FormatBool(*o.Topic))
+	}
+	return query
+}
+
 func (c *Client) OrganizationRepositoryCreate(ctx context.Context, owner string, payload *RepositoryCreateRequest) (*Repository, error) {
 	uriRef := url.URL{Path: path.Join("api/v1/orgs", owner, "repos")}
 	response := Repository{}
@@ -140,6 +191,22 @@ func (c *Client) UserRepositoryCreate(ctx context.Context, payload *RepositoryCr
 	return &response, nil
 }
 
+func (c *Client) RepositoryAvatarDelete(ctx context.Context, owner string, repo string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "avatar")}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository avatar: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryAvatarUpdate(ctx context.Context, owner string, repo string, image string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "avatar")}
+	if _, err := c.send(ctx, "POST", &uriRef, &AvatarUpdateRequest{Image: image}, nil); err != nil {
+		return fmt.Errorf("failed to update repository avatar: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) RepositoryGet(ctx context.Context, owner string, repo string) (*Repository, error) {
 	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo)}
 	response := Repository{}
@@ -157,13 +224,13 @@ func (c *Client) RepositoryDelete(ctx context.Context, owner string, repo string
 	return nil
 }
 
-func (c *Client) RepositoriesList(ctx context.Context) ([]Repository, error) {
+func (c *Client) RepositoriesList(ctx context.Context, options *RepositoriesSearchOptions) ([]Repository, error) {
 	type Response struct {
 		Data []Repository `json:"data"`
 		Ok   bool         `json:"ok"`
 	}
 	uriRef := url.URL{Path: "api/v1/repos/search"}
-	query := make(url.Values)
+	query := options.values()
 	query.Set("limit", c.maxItemsPerPageStr)
 	page := 1
 	var repositories []Repository
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+)
+
+type RepositoryBranch struct {
+	Commit                        *RepositoryBranchCommit `json:"commit"`
+	EffectiveBranchProtectionName string                  `json:"effective_branch_protection_name"`
+	EnableStatusCheck             bool                    `json:"enable_status_check"`
+	Name                          string                  `json:"name"`
+	Protected                     bool                    `json:"protected"`
+	RequiredApprovals             int64                   `json:"required_approvals"`
+	StatusCheckContexts           []string                `json:"status_check_contexts"`
+	UserCanMerge                  bool                    `json:"user_can_merge"`
+	UserCanPush                   bool                    `json:"user_can_push"`
+}
+
+type RepositoryBranchCommit struct {
+	Id        string    `json:"id"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+	Url       string    `json:"url"`
+}
+
+func (c *Client) RepositoryBranchCreate(ctx context.Context, owner string, repo string, name string, oldRefName string) (*RepositoryBranch, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "branches")}
+	type Payload struct {
+		NewBranchName string `json:"new_branch_name"`
+		OldRefName    string `json:"old_ref_name,omitempty"`
+	}
+	payload := Payload{
+		NewBranchName: name,
+		OldRefName:    oldRefName,
+	}
+	var response RepositoryBranch
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository branch: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryBranchDelete(ctx context.Context, owner string, repo string, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "branches", name)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository branch: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryBranchGet(ctx context.Context, owner string, repo string, name string) (*RepositoryBranch, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "branches", name)}
+	var response RepositoryBranch
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository branch: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryBranchRename(ctx context.Context, owner string, repo string, name string, newName string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "branches", name)}
+	type Payload struct {
+		Name string `json:"name"`
+	}
+	payload := Payload{Name: newName}
+	if _, err := c.send(ctx, "PATCH", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to rename repository branch: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryBranchesList(ctx context.Context, owner string, repo string) ([]RepositoryBranch, error) {
+	var response []RepositoryBranch
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "branches")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list repository branches: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type RepositoryDeployKey struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Fingerprint string    `json:"fingerprint"`
+	Id          int64     `json:"id"`
+	Key         string    `json:"key"`
+	KeyId       int64     `json:"key_id"`
+	ReadOnly    bool      `json:"read_only"`
+	Title       string    `json:"title"`
+	Url         string    `json:"url"`
+}
+
+func (c *Client) RepositoryDeployKeyCreate(ctx context.Context, owner string, repo string, title string, key string, readOnly bool) (*RepositoryDeployKey, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "keys")}
+	type Payload struct {
+		Key      string `json:"key"`
+		ReadOnly bool   `json:"read_only"`
+		Title    string `json:"title"`
+	}
+	payload := Payload{
+		Key:      key,
+		ReadOnly: readOnly,
+		Title:    title,
+	}
+	var response RepositoryDeployKey
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository deploy key: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryDeployKeyDelete(ctx context.Context, owner string, repo string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "keys", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository deploy key: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryDeployKeyGet(ctx context.Context, owner string, repo string, id int64) (*RepositoryDeployKey, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "keys", strconv.FormatInt(id, 10))}
+	var response RepositoryDeployKey
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository deploy key: %w", err)
+	}
+	return &response, nil
+}
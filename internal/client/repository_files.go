@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type Identity struct {
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+type RepositoryFile struct {
+	Content       string `json:"content"`
+	Encoding      string `json:"encoding"`
+	LastCommitSha string `json:"last_commit_sha"`
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	Sha           string `json:"sha"`
+	Size          int64  `json:"size"`
+	Type          string `json:"type"`
+}
+
+type RepositoryFileChange struct {
+	Content   string `json:"content,omitempty"`
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	Sha       string `json:"sha,omitempty"`
+}
+
+type RepositoryFileCommit struct {
+	Sha string `json:"sha"`
+}
+
+type RepositoryFileCreateRequest struct {
+	Author    *Identity `json:"author,omitempty"`
+	Branch    string    `json:"branch,omitempty"`
+	Committer *Identity `json:"committer,omitempty"`
+	Content   string    `json:"content"`
+	Message   string    `json:"message,omitempty"`
+}
+
+type RepositoryFileDeleteRequest struct {
+	Author    *Identity `json:"author,omitempty"`
+	Branch    string    `json:"branch,omitempty"`
+	Committer *Identity `json:"committer,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Sha       string    `json:"sha"`
+}
+
+type RepositoryFileResponse struct {
+	Commit  RepositoryFileCommit `json:"commit"`
+	Content *RepositoryFile      `json:"content"`
+}
+
+type RepositoryFileUpdateRequest struct {
+	Author    *Identity `json:"author,omitempty"`
+	Branch    string    `json:"branch,omitempty"`
+	Committer *Identity `json:"committer,omitempty"`
+	Content   string    `json:"content"`
+	Message   string    `json:"message,omitempty"`
+	Sha       string    `json:"sha"`
+}
+
+type RepositoryFilesChangeRequest struct {
+	Author    *Identity              `json:"author,omitempty"`
+	Branch    string                 `json:"branch,omitempty"`
+	Committer *Identity              `json:"committer,omitempty"`
+	Files     []RepositoryFileChange `json:"files"`
+	Message   string                 `json:"message,omitempty"`
+	NewBranch string                 `json:"new_branch,omitempty"`
+}
+
+type RepositoryFilesResponse struct {
+	Commit RepositoryFileCommit `json:"commit"`
+	Files  []*RepositoryFile    `json:"files"`
+}
+
+func (c *Client) RepositoryFileCreate(ctx context.Context, owner string, repo string, filePath string, payload *RepositoryFileCreateRequest) (*RepositoryFileResponse, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "contents", filePath)}
+	var response RepositoryFileResponse
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository file: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryFileDelete(ctx context.Context, owner string, repo string, filePath string, payload *RepositoryFileDeleteRequest) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "contents", filePath)}
+	var response RepositoryFileResponse
+	if _, err := c.send(ctx, "DELETE", &uriRef, payload, &response); err != nil {
+		return fmt.Errorf("failed to delete repository file: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryFileGet(ctx context.Context, owner string, repo string, filePath string, ref string) (*RepositoryFile, error) {
+	uriRef := url.URL{
+		Path:     path.Join("api/v1/repos", owner, repo, "contents", filePath),
+		RawQuery: url.Values{"ref": {ref}}.Encode(),
+	}
+	var response RepositoryFile
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository file: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryFileUpdate(ctx context.Context, owner string, repo string, filePath string, payload *RepositoryFileUpdateRequest) (*RepositoryFileResponse, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "contents", filePath)}
+	var response RepositoryFileResponse
+	if _, err := c.send(ctx, "PUT", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update repository file: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryFilesChange(ctx context.Context, owner string, repo string, payload *RepositoryFilesChangeRequest) (*RepositoryFilesResponse, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "contents")}
+	var response RepositoryFilesResponse
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to change repository files: %w", err)
+	}
+	return &response, nil
+}
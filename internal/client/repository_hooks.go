@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type Hook struct {
+	Active              bool              `json:"active"`
+	AuthorizationHeader string            `json:"authorization_header"`
+	BranchFilter        string            `json:"branch_filter"`
+	Config              map[string]string `json:"config"`
+	CreatedAt           time.Time         `json:"created_at"`
+	Events              []string          `json:"events"`
+	Id                  int64             `json:"id"`
+	Type                string            `json:"type"`
+	UpdatedAt           time.Time         `json:"updated_at"`
+}
+
+type HookCreateRequest struct {
+	Active              bool              `json:"active"`
+	AuthorizationHeader string            `json:"authorization_header"`
+	BranchFilter        string            `json:"branch_filter"`
+	Config              map[string]string `json:"config"`
+	Events              []string          `json:"events"`
+	Type                string            `json:"type"`
+}
+
+type HookUpdateRequest struct {
+	Active              bool              `json:"active"`
+	AuthorizationHeader string            `json:"authorization_header"`
+	BranchFilter        string            `json:"branch_filter"`
+	Config              map[string]string `json:"config"`
+	Events              []string          `json:"events"`
+}
+
+func (c *Client) RepositoryHookCreate(ctx context.Context, owner string, repo string, payload *HookCreateRequest) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "hooks")}
+	var response Hook
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository hook: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryHookDelete(ctx context.Context, owner string, repo string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "hooks", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository hook: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryHookGet(ctx context.Context, owner string, repo string, id int64) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "hooks", strconv.FormatInt(id, 10))}
+	var response Hook
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository hook: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryHookUpdate(ctx context.Context, owner string, repo string, id int64, payload *HookUpdateRequest) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "hooks", strconv.FormatInt(id, 10))}
+	var response Hook
+	if _, err := c.send(ctx, "PATCH", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update repository hook: %w", err)
+	}
+	return &response, nil
+}
@@ -54,6 +54,15 @@ func (c *Client) RepositoryLabelGet(ctx context.Context, owner string, repo stri
 	return &response, nil
 }
 
+func (c *Client) RepositoryLabelsList(ctx context.Context, owner string, repo string) ([]RepositoryLabel, error) {
+	var response []RepositoryLabel
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "labels")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list repository labels: %w", err)
+	}
+	return response, nil
+}
+
 func (c *Client) RepositoryLabelUpdate(ctx context.Context, owner string, repo string, id int64, payload *RepositoryLabelUpdateRequest) (*RepositoryLabel, error) {
 	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "labels", strconv.Itoa(int(id)))}
 	response := RepositoryLabel{}
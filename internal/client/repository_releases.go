@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type RepositoryRelease struct {
+	Assets           []RepositoryReleaseAttachment `json:"assets"`
+	Body             string                        `json:"body"`
+	CreatedAt        time.Time                     `json:"created_at"`
+	Draft            bool                          `json:"draft"`
+	HideArchiveLinks bool                          `json:"hide_archive_links"`
+	HtmlUrl          string                        `json:"html_url"`
+	Id               int64                         `json:"id"`
+	Name             string                        `json:"name"`
+	Prerelease       bool                          `json:"prerelease"`
+	PublishedAt      time.Time                     `json:"published_at"`
+	TagName          string                        `json:"tag_name"`
+	TarballUrl       string                        `json:"tarball_url"`
+	TargetCommitish  string                        `json:"target_commitish"`
+	Url              string                        `json:"url"`
+	ZipballUrl       string                        `json:"zipball_url"`
+}
+
+type RepositoryReleaseAttachment struct {
+	BrowserDownloadUrl string    `json:"browser_download_url"`
+	CreatedAt          time.Time `json:"created_at"`
+	DownloadCount      int64     `json:"download_count"`
+	Id                 int64     `json:"id"`
+	Name               string    `json:"name"`
+	Size               int64     `json:"size"`
+	Type               string    `json:"type"`
+	Uuid               string    `json:"uuid"`
+}
+
+type RepositoryReleaseRequest struct {
+	Body             string `json:"body"`
+	Draft            bool   `json:"draft"`
+	HideArchiveLinks bool   `json:"hide_archive_links"`
+	Name             string `json:"name"`
+	Prerelease       bool   `json:"prerelease"`
+	TagName          string `json:"tag_name"`
+	TargetCommitish  string `json:"target_commitish,omitempty"`
+}
+
+func (c *Client) RepositoryReleaseAttachmentCreate(ctx context.Context, owner string, repo string, releaseId int64, name string, content io.Reader) (*RepositoryReleaseAttachment, error) {
+	uriRef := url.URL{
+		Path:     path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(releaseId, 10), "assets"),
+		RawQuery: url.Values{"name": []string{name}}.Encode(),
+	}
+	// the form is streamed to forgejo instead of buffering the whole file
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		part, err := form.CreateFormFile("attachment", name)
+		if err != nil {
+			writer.CloseWithError(fmt.Errorf("failed to create repository release attachment form: %w", err))
+			return
+		}
+		if _, err := io.Copy(part, content); err != nil {
+			writer.CloseWithError(fmt.Errorf("failed to read repository release attachment content: %w", err))
+			return
+		}
+		writer.CloseWithError(form.Close())
+	}()
+	// unblocks the goroutine if the request fails before consuming the form,
+	// and waits for it so that the caller can safely close the content
+	defer func() {
+		reader.Close()
+		<-done
+	}()
+	payload := rawPayload{
+		body:        reader,
+		contentType: form.FormDataContentType(),
+	}
+	var response RepositoryReleaseAttachment
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository release attachment: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryReleaseAttachmentDelete(ctx context.Context, owner string, repo string, releaseId int64, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(releaseId, 10), "assets", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository release attachment: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryReleaseAttachmentGet(ctx context.Context, owner string, repo string, releaseId int64, id int64) (*RepositoryReleaseAttachment, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(releaseId, 10), "assets", strconv.FormatInt(id, 10))}
+	var response RepositoryReleaseAttachment
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository release attachment: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryReleaseAttachmentRename(ctx context.Context, owner string, repo string, releaseId int64, id int64, name string) (*RepositoryReleaseAttachment, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(releaseId, 10), "assets", strconv.FormatInt(id, 10))}
+	type Payload struct {
+		Name string `json:"name"`
+	}
+	payload := Payload{Name: name}
+	var response RepositoryReleaseAttachment
+	if _, err := c.send(ctx, "PATCH", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to rename repository release attachment: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryReleaseCreate(ctx context.Context, owner string, repo string, payload *RepositoryReleaseRequest) (*RepositoryRelease, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases")}
+	var response RepositoryRelease
+	if _, err := c.send(ctx, "POST", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository release: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryReleaseDelete(ctx context.Context, owner string, repo string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository release: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryReleaseGet(ctx context.Context, owner string, repo string, id int64) (*RepositoryRelease, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(id, 10))}
+	var response RepositoryRelease
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository release: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryReleaseUpdate(ctx context.Context, owner string, repo string, id int64, payload *RepositoryReleaseRequest) (*RepositoryRelease, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "releases", strconv.FormatInt(id, 10))}
+	var response RepositoryRelease
+	if _, err := c.send(ctx, "PATCH", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update repository release: %w", err)
+	}
+	return &response, nil
+}
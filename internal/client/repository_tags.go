@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type RepositoryTag struct {
+	Commit     *RepositoryTagCommit `json:"commit"`
+	Id         string               `json:"id"`
+	Message    string               `json:"message"`
+	Name       string               `json:"name"`
+	TarballUrl string               `json:"tarball_url"`
+	ZipballUrl string               `json:"zipball_url"`
+}
+
+type RepositoryTagCommit struct {
+	Sha string `json:"sha"`
+	Url string `json:"url"`
+}
+
+func (c *Client) RepositoryTagCreate(ctx context.Context, owner string, repo string, name string, message string, target string) (*RepositoryTag, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "tags")}
+	type Payload struct {
+		Message string `json:"message,omitempty"`
+		TagName string `json:"tag_name"`
+		Target  string `json:"target,omitempty"`
+	}
+	payload := Payload{
+		Message: message,
+		TagName: name,
+		Target:  target,
+	}
+	var response RepositoryTag
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create repository tag: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) RepositoryTagDelete(ctx context.Context, owner string, repo string, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "tags", name)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete repository tag: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RepositoryTagGet(ctx context.Context, owner string, repo string, name string) (*RepositoryTag, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "tags", name)}
+	var response RepositoryTag
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository tag: %w", err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+func (c *Client) SystemHookCreate(ctx context.Context, request *HookCreateRequest, isSystemWebhook bool) (*Hook, error) {
+	uriRef := url.URL{Path: "api/v1/admin/hooks"}
+	type Payload struct {
+		*HookCreateRequest
+		IsSystemWebhook bool `json:"is_system_webhook"`
+	}
+	payload := Payload{
+		HookCreateRequest: request,
+		IsSystemWebhook:   isSystemWebhook,
+	}
+	var response Hook
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create system hook: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) SystemHookDelete(ctx context.Context, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/hooks", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete system hook: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) SystemHookGet(ctx context.Context, id int64) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/hooks", strconv.FormatInt(id, 10))}
+	var response Hook
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get system hook: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) SystemHookUpdate(ctx context.Context, id int64, payload *HookUpdateRequest) (*Hook, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/hooks", strconv.FormatInt(id, 10))}
+	var response Hook
+	if _, err := c.send(ctx, "PATCH", &uriRef, payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to update system hook: %w", err)
+	}
+	return &response, nil
+}
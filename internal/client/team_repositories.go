@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+func (c *Client) RepositoryTeamGet(ctx context.Context, owner string, repo string, teamName string) (*Team, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/repos", owner, repo, "teams", teamName)}
+	response := Team{}
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get repository team: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) TeamRepositoriesList(ctx context.Context, id int64) ([]Repository, error) {
+	var response []Repository
+	uriRef := url.URL{Path: path.Join("api/v1/teams", strconv.FormatInt(id, 10), "repos")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list repositories of team %d: %w", id, err)
+	}
+	return response, nil
+}
+
+func (c *Client) TeamRepositoryAdd(ctx context.Context, id int64, organizationName string, repo string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/teams", strconv.FormatInt(id, 10), "repos", organizationName, repo)}
+	if _, err := c.send(ctx, "PUT", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to add repository to team: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) TeamRepositoryDelete(ctx context.Context, id int64, organizationName string, repo string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/teams", strconv.FormatInt(id, 10), "repos", organizationName, repo)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to remove repository from team: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) TeamRepositoryGet(ctx context.Context, id int64, organizationName string, repo string) (*Repository, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/teams", strconv.FormatInt(id, 10), "repos", organizationName, repo)}
+	response := Repository{}
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get team repository: %w", err)
+	}
+	return &response, nil
+}
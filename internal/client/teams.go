@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"path"
 	"strconv"
+	"strings"
 )
 
 type Team struct {
@@ -57,6 +58,28 @@ func (c *Client) TeamGet(ctx context.Context, id int64) (*Team, error) {
 	return &response, nil
 }
 
+func (c *Client) TeamGetByName(ctx context.Context, organizationName string, name string) (*Team, error) {
+	teams, err := c.TeamsSearch(ctx, organizationName, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team %s: %w", name, err)
+	}
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return &team, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find team %s in organization %s", name, organizationName)
+}
+
+func (c *Client) TeamMembersList(ctx context.Context, id int64) ([]User, error) {
+	var response []User
+	uriRef := url.URL{Path: path.Join("api/v1/teams", strconv.FormatInt(id, 10), "members")}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list members of team %d: %w", id, err)
+	}
+	return response, nil
+}
+
 func (c *Client) TeamUpdate(ctx context.Context, id int64, payload *TeamRequest) (*Team, error) {
 	uriRef := url.URL{Path: path.Join("api/v1/teams", strconv.FormatInt(id, 10))}
 	response := Team{}
@@ -74,3 +97,33 @@ func (c *Client) TeamsList(ctx context.Context, organizationName string) ([]Team
 	}
 	return response, nil
 }
+
+func (c *Client) TeamsSearch(ctx context.Context, organizationName string, q string) ([]Team, error) {
+	type Response struct {
+		Data []Team `json:"data"`
+		Ok   bool   `json:"ok"`
+	}
+	uriRef := url.URL{Path: path.Join("api/v1/orgs", organizationName, "teams/search")}
+	query := make(url.Values)
+	query.Set("limit", c.maxItemsPerPageStr)
+	query.Set("q", q)
+	page := 1
+	var teams []Team
+	var response Response
+	for {
+		query.Set("page", strconv.Itoa(page))
+		uriRef.RawQuery = query.Encode()
+		count, err := c.send(ctx, "GET", &uriRef, nil, &response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search teams of organization %s: %w", organizationName, err)
+		}
+		if !response.Ok {
+			return nil, fmt.Errorf("got a non OK status when searching teams of organization %s", organizationName)
+		}
+		teams = append(teams, response.Data...)
+		if count <= page*c.maxItemsPerPage {
+			return teams, nil
+		}
+		page++
+	}
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+func (c *Client) UserActionsSecretCreateOrUpdate(ctx context.Context, name string, data string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/actions/secrets", name)}
+	type Payload struct {
+		Data string `json:"data"`
+	}
+	payload := Payload{Data: data}
+	if _, err := c.send(ctx, "PUT", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to create or update user actions secret: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserActionsSecretDelete(ctx context.Context, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/actions/secrets", name)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user actions secret: %w", err)
+	}
+	return nil
+}
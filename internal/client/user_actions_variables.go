@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type UserActionsVariable = RepositoryActionsVariable
+
+func (c *Client) UserActionsVariableCreate(ctx context.Context, name string, value string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/actions/variables", name)}
+	type Payload struct {
+		Value string `json:"value"`
+	}
+	payload := Payload{Value: value}
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to create user actions variable: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserActionsVariableDelete(ctx context.Context, name string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/actions/variables", name)}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user actions variable: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserActionsVariableGet(ctx context.Context, name string) (*UserActionsVariable, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/user/actions/variables", name)}
+	response := UserActionsVariable{}
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get user actions variable: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) UserActionsVariableUpdate(ctx context.Context, oldName string, newName string, value string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/actions/variables", oldName)}
+	type Payload struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	payload := Payload{Name: newName, Value: value}
+	if _, err := c.send(ctx, "PUT", &uriRef, &payload, nil); err != nil {
+		return fmt.Errorf("failed to update user actions variable: %w", err)
+	}
+	return nil
+}
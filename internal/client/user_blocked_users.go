@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+)
+
+type BlockedUser struct {
+	BlockId   int64     `json:"block_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (c *Client) UserBlockedUserAdd(ctx context.Context, username string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/block", username)}
+	if _, err := c.send(ctx, "PUT", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to block user: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserBlockedUserDelete(ctx context.Context, username string) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/unblock", username)}
+	if _, err := c.send(ctx, "PUT", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to unblock user: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserBlockedUsersList(ctx context.Context) ([]BlockedUser, error) {
+	var response []BlockedUser
+	uriRef := url.URL{Path: "api/v1/user/list_blocked"}
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list blocked users: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type UserGpgKey struct {
+	CanCertify        bool              `json:"can_certify"`
+	CanEncryptComms   bool              `json:"can_encrypt_comms"`
+	CanEncryptStorage bool              `json:"can_encrypt_storage"`
+	CanSign           bool              `json:"can_sign"`
+	CreatedAt         time.Time         `json:"created_at"`
+	Emails            []UserGpgKeyEmail `json:"emails"`
+	ExpiresAt         time.Time         `json:"expires_at"`
+	Id                int64             `json:"id"`
+	KeyId             string            `json:"key_id"`
+	PrimaryKeyId      string            `json:"primary_key_id"`
+	PublicKey         string            `json:"public_key"`
+	Verified          bool              `json:"verified"`
+}
+
+type UserGpgKeyEmail struct {
+	Email    string `json:"email"`
+	Verified bool   `json:"verified"`
+}
+
+func (c *Client) UserGpgKeyCreate(ctx context.Context, armoredPublicKey string, armoredSignature string) (*UserGpgKey, error) {
+	uriRef := url.URL{Path: "api/v1/user/gpg_keys"}
+	type Payload struct {
+		ArmoredPublicKey string `json:"armored_public_key"`
+		ArmoredSignature string `json:"armored_signature,omitempty"`
+	}
+	payload := Payload{
+		ArmoredPublicKey: armoredPublicKey,
+		ArmoredSignature: armoredSignature,
+	}
+	var response UserGpgKey
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create user gpg key: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) UserGpgKeyDelete(ctx context.Context, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/gpg_keys", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user gpg key: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserGpgKeyGet(ctx context.Context, id int64) (*UserGpgKey, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/user/gpg_keys", strconv.FormatInt(id, 10))}
+	var response UserGpgKey
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get user gpg key: %w", err)
+	}
+	return &response, nil
+}
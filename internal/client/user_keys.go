@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+type UserKey struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Fingerprint string    `json:"fingerprint"`
+	Id          int64     `json:"id"`
+	Key         string    `json:"key"`
+	KeyType     string    `json:"key_type"`
+	ReadOnly    bool      `json:"read_only"`
+	Title       string    `json:"title"`
+	Url         string    `json:"url"`
+}
+
+type userKeyCreatePayload struct {
+	Key   string `json:"key"`
+	Title string `json:"title"`
+}
+
+func (c *Client) AdminUserKeyCreate(ctx context.Context, username string, title string, key string) (*UserKey, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/users", username, "keys")}
+	payload := userKeyCreatePayload{
+		Key:   key,
+		Title: title,
+	}
+	var response UserKey
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create user key: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) AdminUserKeyDelete(ctx context.Context, username string, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/admin/users", username, "keys", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user key: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserKeyCreate(ctx context.Context, title string, key string) (*UserKey, error) {
+	uriRef := url.URL{Path: "api/v1/user/keys"}
+	payload := userKeyCreatePayload{
+		Key:   key,
+		Title: title,
+	}
+	var response UserKey
+	if _, err := c.send(ctx, "POST", &uriRef, &payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to create user key: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) UserKeyDelete(ctx context.Context, id int64) error {
+	uriRef := url.URL{Path: path.Join("api/v1/user/keys", strconv.FormatInt(id, 10))}
+	if _, err := c.send(ctx, "DELETE", &uriRef, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user key: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) UserKeysList(ctx context.Context, username string) ([]UserKey, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/users", username, "keys")}
+	var response []UserKey
+	if err := c.sendPaginated(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+	return response, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path"
 	"strconv"
 	"time"
 )
@@ -34,6 +35,25 @@ type User struct {
 	Website          string    `json:"website"`
 }
 
+type UsersSearchOptions struct {
+	Query string
+	Uid   *int64
+}
+
+func (o *UsersSearchOptions) values() url.Values {
+	query := make(url.Values)
+	if o == nil {
+		return query
+	}
+	if o.Query != "" {
+		query.Set("q", o.Query)
+	}
+	if o.Uid != nil {
+		query.Set("uid", strconv.FormatInt(*o.Uid, 10))
+	}
+	return query
+}
+
 func (c *Client) authenticatedUserGet(ctx context.Context) (*User, error) {
 	uriRef := url.URL{Path: "api/v1/user"}
 	var response User
@@ -43,13 +63,33 @@ func (c *Client) authenticatedUserGet(ctx context.Context) (*User, error) {
 	return &response, nil
 }
 
-func (c *Client) UsersList(ctx context.Context) ([]User, error) {
+func (c *Client) UserGet(ctx context.Context, username string) (*User, error) {
+	uriRef := url.URL{Path: path.Join("api/v1/users", username)}
+	var response User
+	if _, err := c.send(ctx, "GET", &uriRef, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return &response, nil
+}
+
+func (c *Client) UserGetById(ctx context.Context, id int64) (*User, error) {
+	users, err := c.UsersList(ctx, &UsersSearchOptions{Uid: &id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search user: %w", err)
+	}
+	if len(users) != 1 {
+		return nil, fmt.Errorf("failed to find user with id %d", id)
+	}
+	return &users[0], nil
+}
+
+func (c *Client) UsersList(ctx context.Context, options *UsersSearchOptions) ([]User, error) {
 	type Response struct {
 		Data []User `json:"data"`
 		Ok   bool   `json:"ok"`
 	}
 	uriRef := url.URL{Path: "api/v1/users/search"}
-	query := make(url.Values)
+	query := options.values()
 	query.Set("limit", c.maxItemsPerPageStr)
 	page := 1
 	var users []User
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AccessTokenEphemeralResource struct {
+	client *client.Client
+}
+
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}              // Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResourceWithClose = &AccessTokenEphemeralResource{}     // Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResourceModel struct {
+	Id             types.Int64    `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Password       types.String   `tfsdk:"password"`
+	Scopes         []types.String `tfsdk:"scopes"`
+	Token          types.String   `tfsdk:"token"`
+	TokenLastEight types.String   `tfsdk:"token_last_eight"`
+	Username       types.String   `tfsdk:"username"`
+}
+
+// accessTokenPrivateData is what Close needs to delete the token.
+type accessTokenPrivateData struct {
+	Id       int64  `json:"id"`
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+const accessTokenPrivateStateKey = "access_token"
+
+var accessTokenScopes = []string{
+	"read:activitypub",
+	"read:admin",
+	"read:issue",
+	"read:misc",
+	"read:notification",
+	"read:organization",
+	"read:package",
+	"read:repository",
+	"read:user",
+	"write:activitypub",
+	"write:admin",
+	"write:issue",
+	"write:misc",
+	"write:notification",
+	"write:organization",
+	"write:package",
+	"write:repository",
+	"write:user",
+}
+
+func (d *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (d *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the access token.",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The access token's name. It must be unique among the user's access tokens.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The user's password. Forgejo only allows managing access tokens with basic authentication, so this is required unless forgejo sits behind a reverse proxy handling authentication.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of scopes granted to the access token, for example `read:repository` or `write:issue`.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(accessTokenScopes...)),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The access token's value.",
+				Sensitive:           true,
+			},
+			"token_last_eight": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last eight characters of the access token's value.",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The login of the user the access token belongs to. Defaults to the user the provider is authenticated as.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Use this ephemeral resource to create a short-lived forgejo access token. The token is deleted when terraform no longer needs it.",
+	}
+}
+
+func (d *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *AccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, accessTokenPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+	var private accessTokenPrivateData
+	if err := json.Unmarshal(privateBytes, &private); err != nil {
+		resp.Diagnostics.AddError("CloseAccessToken", fmt.Sprintf("failed to unmarshal private data: %s", err))
+		return
+	}
+	c := d.client
+	if private.Password != "" {
+		c = c.WithBasicAuth(private.Username, private.Password)
+	}
+	if err := c.AccessTokenDelete(ctx, private.Username, private.Id); err != nil {
+		resp.Diagnostics.AddError("CloseAccessToken", fmt.Sprintf("failed to delete access token: %s", err))
+		return
+	}
+}
+
+func (d *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Username.IsNull() {
+		data.Username = types.StringValue(d.client.AuthenticatedUser())
+	}
+	c := d.client
+	if !data.Password.IsNull() {
+		c = c.WithBasicAuth(data.Username.ValueString(), data.Password.ValueString())
+	}
+	scopes := make([]string, len(data.Scopes))
+	for i, scope := range data.Scopes {
+		scopes[i] = scope.ValueString()
+	}
+	token, err := c.AccessTokenCreate(ctx, data.Username.ValueString(), data.Name.ValueString(), scopes)
+	if err != nil {
+		resp.Diagnostics.AddError("OpenAccessToken", fmt.Sprintf("failed to create access token: %s", err))
+		return
+	}
+	privateBytes, err := json.Marshal(accessTokenPrivateData{
+		Id:       token.Id,
+		Password: data.Password.ValueString(),
+		Username: data.Username.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("OpenAccessToken", fmt.Sprintf("failed to marshal private data: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessTokenPrivateStateKey, privateBytes)...)
+	data.Id = types.Int64Value(token.Id)
+	data.Token = types.StringValue(token.Sha1)
+	data.TokenLastEight = types.StringValue(token.TokenLastEight)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
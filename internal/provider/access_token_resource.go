@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AccessTokenResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &AccessTokenResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewAccessTokenResource() resource.Resource {
+	return &AccessTokenResource{}
+}
+
+type AccessTokenResourceModel struct {
+	Id             types.Int64    `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	PasswordWo     types.String   `tfsdk:"password_wo"`
+	Scopes         []types.String `tfsdk:"scopes"`
+	Token          types.String   `tfsdk:"token"`
+	TokenLastEight types.String   `tfsdk:"token_last_eight"`
+	Username       types.String   `tfsdk:"username"`
+}
+
+func (d *AccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (d *AccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the access token.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The access token's name. It must be unique among the user's access tokens.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The user's password as a write-only attribute that is never stored in the terraform state. Requires terraform 1.11 or later. Forgejo only allows managing access tokens with basic authentication, so this is required to create the access token unless forgejo sits behind a reverse proxy handling authentication. Since terraform does not provide it when refreshing or destroying the access token, the provider's `password` is used instead, which is also the fallback when creating the access token.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"scopes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of scopes granted to the access token, for example `read:repository` or `write:issue`. Changing it replaces the access token.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(accessTokenScopes...)),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The access token's value.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Sensitive: true,
+			},
+			"token_last_eight": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last eight characters of the access token's value.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Sensitive: true,
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The login of the user the access token belongs to. Defaults to the user the provider is authenticated as.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a forgejo access token. If the access token is deleted outside of terraform, the next apply will create a new one. Detecting it requires the provider's `password` unless forgejo sits behind a reverse proxy handling authentication, and so does destroying the access token.",
+	}
+}
+
+func (d *AccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// accessTokenClient authenticates with the write-only password which is only
+// available in the configuration during create operations, or the provider's
+// password.
+func (d *AccessTokenResource) accessTokenClient(username types.String, passwordWo types.String) (*client.Client, bool) {
+	password := passwordWo.ValueString()
+	if password == "" {
+		password = d.client.Password()
+	}
+	if password == "" {
+		return d.client, false
+	}
+	return d.client.WithBasicAuth(username.ValueString(), password), true
+}
+
+func (d *AccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var passwordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Username.IsUnknown() {
+		data.Username = types.StringValue(d.client.AuthenticatedUser())
+	}
+	scopes := make([]string, len(data.Scopes))
+	for i, scope := range data.Scopes {
+		scopes[i] = scope.ValueString()
+	}
+	accessTokenClient, _ := d.accessTokenClient(data.Username, passwordWo)
+	token, err := accessTokenClient.AccessTokenCreate(
+		ctx,
+		data.Username.ValueString(),
+		data.Name.ValueString(),
+		scopes)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateAccessToken", fmt.Sprintf("failed to create access token: %s", err))
+		return
+	}
+	data.Id = types.Int64Value(token.Id)
+	data.Token = types.StringValue(token.Sha1)
+	data.TokenLastEight = types.StringValue(token.TokenLastEight)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *AccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	accessTokenClient, hasPassword := d.accessTokenClient(data.Username, types.StringNull())
+	err := accessTokenClient.AccessTokenDelete(
+		ctx,
+		data.Username.ValueString(),
+		data.Id.ValueInt64())
+	if err != nil {
+		if !hasPassword && client.IsStatusCode(err, http.StatusUnauthorized) {
+			resp.Diagnostics.AddError("DeleteAccessToken", fmt.Sprintf("failed to delete access token, set the provider's password: %s", err))
+			return
+		}
+		resp.Diagnostics.AddError("DeleteAccessToken", fmt.Sprintf("failed to delete access token: %s", err))
+		return
+	}
+}
+
+func (d *AccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	accessTokenClient, hasPassword := d.accessTokenClient(data.Username, types.StringNull())
+	tokens, err := accessTokenClient.AccessTokensList(ctx, data.Username.ValueString())
+	if !hasPassword && client.IsStatusCode(err, http.StatusUnauthorized) {
+		resp.Diagnostics.AddWarning("ReadAccessToken", fmt.Sprintf("cannot detect whether the access token was deleted outside of terraform, set the provider's password: %s", err))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadAccessToken", fmt.Sprintf("failed to list access tokens: %s", err))
+		return
+	}
+	for _, token := range tokens {
+		if token.Id == data.Id.ValueInt64() {
+			data.Name = types.StringValue(token.Name)
+			data.TokenLastEight = types.StringValue(token.TokenLastEight)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+	// the access token was deleted outside of terraform
+	resp.State.RemoveResource(ctx)
+}
+
+func (d *AccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UpdateAccessToken", "unreachable code")
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// avatarMaxSize is forgejo's default AVATAR_MAX_FILE_SIZE
+const avatarMaxSize = 1024 * 1024
+
+var avatarContentTypes = []string{"image/gif", "image/jpeg", "image/png", "image/webp"}
+
+type AvatarResourceModel struct {
+	Avatar       types.String `tfsdk:"avatar"`
+	AvatarFile   types.String `tfsdk:"avatar_file"`
+	AvatarSha256 types.String `tfsdk:"avatar_sha256"`
+}
+
+// avatarImage returns the configured avatar's content, or nil when no avatar
+// is configured.
+func avatarImage(avatar types.String, avatarFile types.String) ([]byte, error) {
+	if !avatar.IsNull() {
+		image, err := base64.StdEncoding.DecodeString(avatar.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode avatar: %w", err)
+		}
+		return image, nil
+	}
+	if !avatarFile.IsNull() {
+		image, err := os.ReadFile(avatarFile.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read avatar file: %w", err)
+		}
+		return image, nil
+	}
+	return nil, nil
+}
+
+func avatarSchemaAttributes(owner string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"avatar": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s's avatar as a base64 encoded GIF, JPEG, PNG or WebP image. Conflicts with `avatar_file`.", owner),
+			Optional:            true,
+			Validators: []validator.String{
+				avatarValidator{},
+				stringvalidator.ConflictsWith(path.MatchRoot("avatar_file")),
+			},
+		},
+		"avatar_file": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The path to a local GIF, JPEG, PNG or WebP image to use as the %s's avatar. Conflicts with `avatar`.", owner),
+			Optional:            true,
+			Validators: []validator.String{
+				avatarValidator{file: true},
+			},
+		},
+		"avatar_sha256": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The SHA256 hash of the avatar's content, used to detect changes to `avatar_file`.",
+			PlanModifiers: []planmodifier.String{
+				avatarSha256PlanModifier{},
+			},
+		},
+	}
+}
+
+func validateAvatarImage(image []byte) error {
+	if len(image) > avatarMaxSize {
+		return fmt.Errorf("image is %d bytes long", len(image))
+	}
+	if contentType := http.DetectContentType(image); !slices.Contains(avatarContentTypes, contentType) {
+		return fmt.Errorf("unsupported content type %q", contentType)
+	}
+	return nil
+}
+
+// updateAvatar uploads or deletes the avatar when its content changed, then
+// records the hash of the uploaded content.
+func updateAvatar(plannedData *AvatarResourceModel, stateData *AvatarResourceModel, upload func(image string) error, remove func() error) error {
+	image, err := avatarImage(plannedData.Avatar, plannedData.AvatarFile)
+	if err != nil {
+		return err
+	}
+	sha256sum := types.StringNull()
+	if image != nil {
+		sha256sum = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(image)))
+	}
+	plannedData.AvatarSha256 = sha256sum
+	if sha256sum.Equal(stateData.AvatarSha256) {
+		return nil
+	}
+	if image == nil {
+		return remove()
+	}
+	return upload(base64.StdEncoding.EncodeToString(image))
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationActionsSecretResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &OrganizationActionsSecretResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &OrganizationActionsSecretResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationActionsSecretResource() resource.Resource {
+	return &OrganizationActionsSecretResource{}
+}
+
+type OrganizationActionsSecretResourceModel struct {
+	CreatedAt        timetypes.RFC3339 `tfsdk:"created_at"`
+	Data             types.String      `tfsdk:"data"`
+	DataWo           types.String      `tfsdk:"data_wo"`
+	DataWoVersion    types.Int64       `tfsdk:"data_wo_version"`
+	Name             types.String      `tfsdk:"name"`
+	OrganizationName types.String      `tfsdk:"organization_name"`
+}
+
+func (d *OrganizationActionsSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_actions_secret"
+}
+
+func (d *OrganizationActionsSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The secret's creation date and time.",
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "The secret's data. Since it cannot be read back from forgejo, it is null after an import and the next apply will set it. Exactly one of `data` and `data_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data_wo")),
+				},
+			},
+			"data_wo": schema.StringAttribute{
+				MarkdownDescription: "The secret's data as a write-only attribute that is never stored in the terraform state. Requires terraform 1.11 or later. Since changes to it cannot be detected, bump `data_wo_version` to update the secret.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("data_wo_version")),
+				},
+				WriteOnly: true,
+			},
+			"data_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "An arbitrary version of the `data_wo` attribute. Changing it updates the secret with the current value of `data_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("data_wo")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The secret's name. It must be uppercase or the plan will not be idempotent.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization the secret belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage an organization actions secret.",
+	}
+}
+
+func (d *OrganizationActionsSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *OrganizationActionsSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationActionsSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var dataWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationActionsSecretCreateOrUpdate(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Name.ValueString(),
+		actionsSecretData(data.Data, dataWo))
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationActionsSecret", fmt.Sprintf("failed to create or update organization actions secret: %s", err))
+		return
+	}
+	secret, err := d.getOrganizationActionsSecret(ctx, data.OrganizationName, data.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationActionsSecret", err.Error())
+		return
+	}
+	data.CreatedAt = secret.CreatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationActionsSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationActionsSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationActionsSecretDelete(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteOrganizationActionsSecret", fmt.Sprintf("failed to delete organization actions secret: %s", err))
+		return
+	}
+}
+
+func (d *OrganizationActionsSecretResource) getOrganizationActionsSecret(
+	ctx context.Context,
+	organizationName types.String,
+	name types.String,
+) (*OrganizationActionsSecretResourceModel, error) {
+	secrets, err := d.client.OrganizationActionsSecretsList(
+		ctx,
+		organizationName.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization actions secrets: %w", err)
+	}
+	nameStr := strings.ToUpper(name.ValueString())
+	for _, secret := range secrets {
+		if secret.Name == nameStr {
+			created := timetypes.NewRFC3339TimeValue(secret.CreatedAt)
+			return &OrganizationActionsSecretResourceModel{
+				CreatedAt: created,
+				Name:      types.StringValue(secret.Name),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find organization actions secret")
+}
+
+func (r *OrganizationActionsSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/secretName. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+func (d *OrganizationActionsSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationActionsSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secret, err := d.getOrganizationActionsSecret(ctx, data.OrganizationName, data.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationActionsSecret", err.Error())
+		return
+	}
+	data.CreatedAt = secret.CreatedAt
+	data.Name = secret.Name
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationActionsSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationActionsSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var dataWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationActionsSecretCreateOrUpdate(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Name.ValueString(),
+		actionsSecretData(data.Data, dataWo))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateOrganizationActionsSecret", fmt.Sprintf("failed to create or update organization actions secret: %s", err))
+		return
+	}
+	secret, err := d.getOrganizationActionsSecret(ctx, data.OrganizationName, data.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateOrganizationActionsSecret", err.Error())
+		return
+	}
+	data.CreatedAt = secret.CreatedAt
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationActionsSecretsDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &OrganizationActionsSecretsDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationActionsSecretsDataSource() datasource.DataSource {
+	return &OrganizationActionsSecretsDataSource{}
+}
+
+type OrganizationActionsSecretsDataSourceModel struct {
+	Elements         []ActionsSecretDataSourceModel `tfsdk:"elements"`
+	OrganizationName types.String                   `tfsdk:"organization_name"`
+}
+
+type ActionsSecretDataSourceModel struct {
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
+	Name      types.String      `tfsdk:"name"`
+}
+
+func (d *OrganizationActionsSecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_actions_secrets"
+}
+
+var actionsSecretSchemaAttributes = map[string]schema.Attribute{
+	"created_at": schema.StringAttribute{
+		Computed:            true,
+		CustomType:          timetypes.RFC3339Type{},
+		MarkdownDescription: "The secret's creation date and time.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The secret's name.",
+	},
+}
+
+func (d *OrganizationActionsSecretsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of actions secrets of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: actionsSecretSchemaAttributes,
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization the secrets belong to.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo actions secrets belonging to an organization.",
+	}
+}
+
+func (d *OrganizationActionsSecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func populateActionsSecretDataSourceModels(secrets []client.RepositoryActionsSecret) []ActionsSecretDataSourceModel {
+	secretsList := make([]ActionsSecretDataSourceModel, len(secrets))
+	for i, secret := range secrets {
+		secretsList[i] = ActionsSecretDataSourceModel{
+			CreatedAt: timetypes.NewRFC3339TimeValue(secret.CreatedAt),
+			Name:      types.StringValue(secret.Name),
+		}
+	}
+	return secretsList
+}
+
+func (d *OrganizationActionsSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationActionsSecretsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secrets, err := d.client.OrganizationActionsSecretsList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ListOrganizationActionsSecrets", fmt.Sprintf("failed to list organization actions secrets: %s", err))
+		return
+	}
+	data.Elements = populateActionsSecretDataSourceModels(secrets)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
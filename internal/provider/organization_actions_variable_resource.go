@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationActionsVariableResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &OrganizationActionsVariableResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &OrganizationActionsVariableResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationActionsVariableResource() resource.Resource {
+	return &OrganizationActionsVariableResource{}
+}
+
+type OrganizationActionsVariableResourceModel struct {
+	Data             types.String `tfsdk:"data"`
+	Name             types.String `tfsdk:"name"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+}
+
+func (d *OrganizationActionsVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_actions_variable"
+}
+
+func (d *OrganizationActionsVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.StringAttribute{
+				MarkdownDescription: "The variable's data.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The variable's name. It must be uppercase or the plan will not be idempotent.",
+				Required:            true,
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization the variable belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage an organization actions variable.",
+	}
+}
+
+func (d *OrganizationActionsVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *OrganizationActionsVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationActionsVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationActionsVariableCreate(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Name.ValueString(),
+		data.Data.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationActionsVariable", fmt.Sprintf("failed to create organization actions variable: %s\nTry importing the resource instead?", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationActionsVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationActionsVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationActionsVariableDelete(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteOrganizationActionsVariable", fmt.Sprintf("failed to delete organization actions variable: %s", err))
+		return
+	}
+}
+
+func (r *OrganizationActionsVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/variableName. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+func (d *OrganizationActionsVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationActionsVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	variable, err := d.client.OrganizationActionsVariableGet(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadOrganizationActionsVariable", fmt.Sprintf("failed to get organization actions variable: %s", err))
+		return
+	}
+	data.Data = types.StringValue(variable.Data)
+	data.Name = types.StringValue(variable.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationActionsVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData OrganizationActionsVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData OrganizationActionsVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationActionsVariableUpdate(
+		ctx,
+		plannedData.OrganizationName.ValueString(),
+		stateData.Name.ValueString(),
+		plannedData.Name.ValueString(),
+		plannedData.Data.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateOrganizationActionsVariable", fmt.Sprintf("failed to update organization actions variable: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationActionsVariablesDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &OrganizationActionsVariablesDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationActionsVariablesDataSource() datasource.DataSource {
+	return &OrganizationActionsVariablesDataSource{}
+}
+
+type OrganizationActionsVariablesDataSourceModel struct {
+	Elements         []ActionsVariableDataSourceModel `tfsdk:"elements"`
+	OrganizationName types.String                     `tfsdk:"organization_name"`
+}
+
+type ActionsVariableDataSourceModel struct {
+	Data types.String `tfsdk:"data"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *OrganizationActionsVariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_actions_variables"
+}
+
+var actionsVariableSchemaAttributes = map[string]schema.Attribute{
+	"data": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The variable's data.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The variable's name.",
+	},
+}
+
+func (d *OrganizationActionsVariablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of actions variables of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: actionsVariableSchemaAttributes,
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization the variables belong to.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo actions variables belonging to an organization.",
+	}
+}
+
+func (d *OrganizationActionsVariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func populateActionsVariableDataSourceModels(variables []client.RepositoryActionsVariable) []ActionsVariableDataSourceModel {
+	variablesList := make([]ActionsVariableDataSourceModel, len(variables))
+	for i, variable := range variables {
+		variablesList[i] = ActionsVariableDataSourceModel{
+			Data: types.StringValue(variable.Data),
+			Name: types.StringValue(variable.Name),
+		}
+	}
+	return variablesList
+}
+
+func (d *OrganizationActionsVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationActionsVariablesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	variables, err := d.client.OrganizationActionsVariablesList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ListOrganizationActionsVariables", fmt.Sprintf("failed to list organization actions variables: %s", err))
+		return
+	}
+	data.Elements = populateActionsVariableDataSourceModels(variables)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
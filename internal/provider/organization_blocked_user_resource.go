@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationBlockedUserResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &OrganizationBlockedUserResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &OrganizationBlockedUserResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationBlockedUserResource() resource.Resource {
+	return &OrganizationBlockedUserResource{}
+}
+
+type OrganizationBlockedUserResourceModel struct {
+	CreatedAt        timetypes.RFC3339 `tfsdk:"created_at"`
+	OrganizationName types.String      `tfsdk:"organization_name"`
+	UserId           types.Int64       `tfsdk:"user_id"`
+	Username         types.String      `tfsdk:"username"`
+}
+
+func (d *OrganizationBlockedUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_blocked_user"
+}
+
+func (d *OrganizationBlockedUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The date and time at which the user was blocked.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization blocking the user.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the blocked user.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The login of the blocked user.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this resource to block a user from interacting with an organization.",
+	}
+}
+
+func (d *OrganizationBlockedUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *OrganizationBlockedUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationBlockedUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	user, err := d.client.UserGet(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationBlockedUser", fmt.Sprintf("failed to get user: %s", err))
+		return
+	}
+	err = d.client.OrganizationBlockedUserAdd(ctx, data.OrganizationName.ValueString(), data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationBlockedUser", fmt.Sprintf("failed to block user from organization: %s", err))
+		return
+	}
+	blockedUsers, err := d.client.OrganizationBlockedUsersList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationBlockedUser", fmt.Sprintf("failed to list organization blocked users: %s", err))
+		return
+	}
+	index := slices.IndexFunc(blockedUsers, func(blockedUser client.BlockedUser) bool {
+		return blockedUser.BlockId == user.Id
+	})
+	if index < 0 {
+		resp.Diagnostics.AddError("CreateOrganizationBlockedUser", "failed to find the blocked user after blocking it")
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(blockedUsers[index].CreatedAt)
+	data.UserId = types.Int64Value(user.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationBlockedUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationBlockedUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationBlockedUserDelete(ctx, data.OrganizationName.ValueString(), data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteOrganizationBlockedUser", fmt.Sprintf("failed to unblock user from organization: %s", err))
+		return
+	}
+}
+
+func (r *OrganizationBlockedUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/username. Got: %q", req.ID),
+		)
+		return
+	}
+	user, err := r.client.UserGet(ctx, idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("ImportOrganizationBlockedUser", fmt.Sprintf("failed to get user: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), user.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), user.Login)...)
+}
+
+func (d *OrganizationBlockedUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationBlockedUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blockedUsers, err := d.client.OrganizationBlockedUsersList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadOrganizationBlockedUser", fmt.Sprintf("failed to list organization blocked users: %s", err))
+		return
+	}
+	index := slices.IndexFunc(blockedUsers, func(blockedUser client.BlockedUser) bool {
+		return blockedUser.BlockId == data.UserId.ValueInt64()
+	})
+	if index < 0 {
+		// the user was unblocked outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(blockedUsers[index].CreatedAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationBlockedUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UpdateOrganizationBlockedUser", "unreachable code")
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationBlockedUsersDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &OrganizationBlockedUsersDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationBlockedUsersDataSource() datasource.DataSource {
+	return &OrganizationBlockedUsersDataSource{}
+}
+
+type OrganizationBlockedUsersDataSourceModel struct {
+	Elements         []BlockedUserDataSourceModel `tfsdk:"elements"`
+	OrganizationName types.String                 `tfsdk:"organization_name"`
+}
+
+type BlockedUserDataSourceModel struct {
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
+	UserId    types.Int64       `tfsdk:"user_id"`
+}
+
+func (d *OrganizationBlockedUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_blocked_users"
+}
+
+var blockedUserSchemaAttributes = map[string]schema.Attribute{
+	"created_at": schema.StringAttribute{
+		Computed:            true,
+		CustomType:          timetypes.RFC3339Type{},
+		MarkdownDescription: "The date and time at which the user was blocked.",
+	},
+	"user_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The identifier of the blocked user.",
+	},
+}
+
+func (d *OrganizationBlockedUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of users blocked by the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: blockedUserSchemaAttributes,
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo organization blocked users.",
+	}
+}
+
+func (d *OrganizationBlockedUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func populateBlockedUserDataSourceModels(blockedUsers []client.BlockedUser) []BlockedUserDataSourceModel {
+	elements := make([]BlockedUserDataSourceModel, len(blockedUsers))
+	for i, blockedUser := range blockedUsers {
+		elements[i] = BlockedUserDataSourceModel{
+			CreatedAt: timetypes.NewRFC3339TimeValue(blockedUser.CreatedAt),
+			UserId:    types.Int64Value(blockedUser.BlockId),
+		}
+	}
+	return elements
+}
+
+func (d *OrganizationBlockedUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationBlockedUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blockedUsers, err := d.client.OrganizationBlockedUsersList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ListOrganizationBlockedUsers", fmt.Sprintf("failed to list organization blocked users: %s", err))
+		return
+	}
+	data.Elements = populateBlockedUserDataSourceModels(blockedUsers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
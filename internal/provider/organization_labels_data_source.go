@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationLabelsDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &OrganizationLabelsDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationLabelsDataSource() datasource.DataSource {
+	return &OrganizationLabelsDataSource{}
+}
+
+type OrganizationLabelsDataSourceModel struct {
+	Elements         []LabelDataSourceModel `tfsdk:"elements"`
+	OrganizationName types.String           `tfsdk:"organization_name"`
+}
+
+type LabelDataSourceModel struct {
+	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	Id          types.Int64  `tfsdk:"id"`
+	IsArchived  types.Bool   `tfsdk:"is_archived"`
+	Name        types.String `tfsdk:"name"`
+	Url         types.String `tfsdk:"url"`
+}
+
+func (d *OrganizationLabelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_labels"
+}
+
+var labelSchemaAttributes = map[string]schema.Attribute{
+	"color": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The label's color in lowercase rgb format, without a leading `#`.",
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "A description string.",
+	},
+	"exclusive": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Whether the label is exclusive or not.",
+	},
+	"id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The identifier of the label.",
+	},
+	"is_archived": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Whether the label is archived or not.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The label's name.",
+	},
+	"url": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The label's URL.",
+	},
+}
+
+func (d *OrganizationLabelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of labels of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: labelSchemaAttributes,
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization the labels belong to.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo labels belonging to an organization.",
+	}
+}
+
+func (d *OrganizationLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func populateLabelDataSourceModel(label *client.RepositoryLabel) *LabelDataSourceModel {
+	return &LabelDataSourceModel{
+		Color:       types.StringValue(label.Color),
+		Description: types.StringValue(label.Description),
+		Exclusive:   types.BoolValue(label.Exclusive),
+		Id:          types.Int64Value(label.Id),
+		IsArchived:  types.BoolValue(label.IsArchived),
+		Name:        types.StringValue(label.Name),
+		Url:         types.StringValue(label.Url),
+	}
+}
+
+func (d *OrganizationLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationLabelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	labels, err := d.client.OrganizationLabelsList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ListOrganizationLabels", fmt.Sprintf("failed to list organization labels: %s", err))
+		return
+	}
+	labelsList := make([]LabelDataSourceModel, len(labels))
+	for i, label := range labels {
+		labelsList[i] = *populateLabelDataSourceModel(&label)
+	}
+	data.Elements = labelsList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
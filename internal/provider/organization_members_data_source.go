@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationMembersDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &OrganizationMembersDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &OrganizationMembersDataSource{}
+}
+
+type OrganizationMembersDataSourceModel struct {
+	Elements         []UserDataSourceModel `tfsdk:"elements"`
+	OrganizationName types.String          `tfsdk:"organization_name"`
+	PublicOnly       types.Bool            `tfsdk:"public_only"`
+}
+
+func (d *OrganizationMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (d *OrganizationMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of organization members.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataSourceSchemaAttributes,
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization.",
+				Required:            true,
+			},
+			"public_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to only list the members who made their membership public or not. Defaults to `false`. Listing concealed members requires being a member of the organization.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo organization members.",
+	}
+}
+
+func (d *OrganizationMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *OrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var members []client.User
+	var err error
+	if data.PublicOnly.ValueBool() {
+		members, err = d.client.OrganizationPublicMembersList(ctx, data.OrganizationName.ValueString())
+	} else {
+		members, err = d.client.OrganizationMembersList(ctx, data.OrganizationName.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ListOrganizationMembers", fmt.Sprintf("failed to list organization members: %s", err))
+		return
+	}
+	data.Elements = make([]UserDataSourceModel, len(members))
+	for i, member := range members {
+		data.Elements[i] = *populateUserDataSourceModel(&member)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationPublicMembershipResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &OrganizationPublicMembershipResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &OrganizationPublicMembershipResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationPublicMembershipResource() resource.Resource {
+	return &OrganizationPublicMembershipResource{}
+}
+
+type OrganizationPublicMembershipResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Username         types.String `tfsdk:"username"`
+}
+
+func (d *OrganizationPublicMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_public_membership"
+}
+
+func (d *OrganizationPublicMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The login of the organization member. Defaults to the user the provider is authenticated as. Forgejo only allows users to publicize or conceal their own membership, so this is mostly useful for imports.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Use this resource to make a user's membership of an organization public. The user must already be a member of the organization.",
+	}
+}
+
+func (d *OrganizationPublicMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *OrganizationPublicMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationPublicMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Username.IsUnknown() {
+		data.Username = types.StringValue(d.client.AuthenticatedUser())
+	}
+	err := d.client.OrganizationPublicMemberAdd(ctx, data.OrganizationName.ValueString(), data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationPublicMembership", fmt.Sprintf("failed to publicize organization membership: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationPublicMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationPublicMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationPublicMemberDelete(ctx, data.OrganizationName.ValueString(), data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteOrganizationPublicMembership", fmt.Sprintf("failed to conceal organization membership: %s", err))
+		return
+	}
+}
+
+func (r *OrganizationPublicMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/username. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), idParts[1])...)
+}
+
+func (d *OrganizationPublicMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationPublicMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	members, err := d.client.OrganizationPublicMembersList(ctx, data.OrganizationName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadOrganizationPublicMembership", fmt.Sprintf("failed to list organization public members: %s", err))
+		return
+	}
+	if !slices.ContainsFunc(members, func(member client.User) bool {
+		return member.Login == data.Username.ValueString()
+	}) {
+		// the membership was concealed outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationPublicMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UpdateOrganizationPublicMembership", "unreachable code")
+}
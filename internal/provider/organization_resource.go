@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,6 +27,7 @@ func NewOrganizationResource() resource.Resource {
 }
 
 type OrganizationResourceModel struct {
+	AvatarResourceModel
 	Description               types.String `tfsdk:"description"`
 	Email                     types.String `tfsdk:"email"`
 	FullName                  types.String `tfsdk:"full_name"`
@@ -95,6 +97,7 @@ func (d *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 		},
 		MarkdownDescription: "Use this resource to create and manage an organization.",
 	}
+	maps.Copy(resp.Schema.Attributes, avatarSchemaAttributes("organization"))
 }
 
 func (d *OrganizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -144,6 +147,25 @@ func (d *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	data.RepoAdminChangeTeamAccess = types.BoolValue(organization.RepoAdminChangeTeamAccess)
 	data.Visibility = types.StringValue(organization.Visibility)
 	data.Website = types.StringValue(organization.Website)
+	// the organization exists even if setting its avatar fails, the next apply retries it
+	avatar := data.AvatarResourceModel
+	data.AvatarSha256 = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AvatarResourceModel = avatar
+	err = updateAvatar(
+		&data.AvatarResourceModel,
+		&AvatarResourceModel{},
+		func(image string) error {
+			return d.client.OrganizationAvatarUpdate(ctx, organization.Name, image)
+		},
+		func() error { return d.client.OrganizationAvatarDelete(ctx, organization.Name) })
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganization", fmt.Sprintf("failed to set organization avatar: %s", err))
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -227,6 +249,17 @@ func (d *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		resp.Diagnostics.AddError("UpdateOrganization", fmt.Sprintf("failed to update organization: %s", err))
 		return
 	}
+	err = updateAvatar(
+		&plannedData.AvatarResourceModel,
+		&stateData.AvatarResourceModel,
+		func(image string) error {
+			return d.client.OrganizationAvatarUpdate(ctx, organization.Name, image)
+		},
+		func() error { return d.client.OrganizationAvatarDelete(ctx, organization.Name) })
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateOrganization", fmt.Sprintf("failed to update organization avatar: %s", err))
+		return
+	}
 	plannedData.Description = types.StringValue(organization.Description)
 	plannedData.Email = types.StringValue(organization.Email)
 	plannedData.FullName = types.StringValue(organization.FullName)
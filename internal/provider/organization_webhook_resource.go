@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type OrganizationWebhookResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &OrganizationWebhookResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &OrganizationWebhookResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewOrganizationWebhookResource() resource.Resource {
+	return &OrganizationWebhookResource{}
+}
+
+type OrganizationWebhookResourceModel struct {
+	WebhookResourceModel
+	OrganizationName types.String `tfsdk:"organization_name"`
+}
+
+func (d *OrganizationWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_webhook"
+}
+
+func (d *OrganizationWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := webhookSchemaAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"organization_name": schema.StringAttribute{
+			MarkdownDescription: "The name of the organization on which to configure a webhook.",
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Required:            true,
+		},
+	})
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Use this resource to create and manage an organization webhook, triggered by the events of all the organization's repositories.",
+	}
+}
+
+func (d *OrganizationWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *OrganizationWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.OrganizationHookCreate(
+		ctx,
+		data.OrganizationName.ValueString(),
+		webhookCreateRequest(&data.WebhookResourceModel))
+	if err != nil {
+		resp.Diagnostics.AddError("CreateOrganizationWebhook", fmt.Sprintf("failed to create organization webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.OrganizationHookDelete(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteOrganizationWebhook", fmt.Sprintf("failed to delete organization webhook: %s", err))
+		return
+	}
+}
+
+func (r *OrganizationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/webhookId. Got: %q", req.ID),
+		)
+		return
+	}
+	id, err := strconv.ParseInt(idParts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected webhook identifier to be an integer. Got: %q", idParts[1]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (d *OrganizationWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.OrganizationHookGet(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the webhook was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadOrganizationWebhook", fmt.Sprintf("failed to get organization webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *OrganizationWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.OrganizationHookUpdate(
+		ctx,
+		data.OrganizationName.ValueString(),
+		data.Id.ValueInt64(),
+		webhookUpdateRequest(&data.WebhookResourceModel))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateOrganizationWebhook", fmt.Sprintf("failed to update organization webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
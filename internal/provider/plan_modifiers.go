@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+const importedPrivateStateKey = "imported"
+
+const requiresReplaceUnlessImportedDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the resource was just imported and the attribute was null."
+
+const sshPublicKeyRequiresReplaceDescription = "If the value of this attribute changes other than its comment, Terraform will destroy and recreate the resource."
+
+// avatarSha256PlanModifier computes the hash of the configured avatar so that
+// changes to the content of an avatar file show up in plans.
+type avatarSha256PlanModifier struct{}
+
+var _ planmodifier.String = avatarSha256PlanModifier{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (m avatarSha256PlanModifier) Description(ctx context.Context) string {
+	return "The value of this attribute is the SHA256 hash of the configured avatar."
+}
+
+func (m avatarSha256PlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m avatarSha256PlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var avatar, avatarFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("avatar"), &avatar)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("avatar_file"), &avatarFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if avatar.IsUnknown() || avatarFile.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	image, err := avatarImage(avatar, avatarFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Avatar", fmt.Sprintf("failed to load avatar: %s", err))
+		return
+	}
+	if image == nil {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+	resp.PlanValue = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(image)))
+}
+
+// fileBaseNamePlanModifier defaults a name to the base name of the file
+// configured in another attribute.
+type fileBaseNamePlanModifier struct {
+	attribute string
+}
+
+var _ planmodifier.String = fileBaseNamePlanModifier{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (m fileBaseNamePlanModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("The value of this attribute defaults to the base name of the file configured in %s.", m.attribute)
+}
+
+func (m fileBaseNamePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m fileBaseNamePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	var file types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.attribute), &file)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if file.IsNull() || file.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	resp.PlanValue = types.StringValue(filepath.Base(file.ValueString()))
+}
+
+// fileSha256PlanModifier computes the hash of the file configured in another
+// attribute so that changes to its content replace the resource.
+type fileSha256PlanModifier struct {
+	attribute string
+}
+
+var _ planmodifier.String = fileSha256PlanModifier{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (m fileSha256PlanModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("The value of this attribute is the SHA256 hash of the file configured in %s. If it changes, Terraform will destroy and recreate the resource.", m.attribute)
+}
+
+func (m fileSha256PlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m fileSha256PlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		// the resource is being destroyed
+		return
+	}
+	var file types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.attribute), &file)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if file.IsNull() || file.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	hash, err := fileSha256(file.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(m.attribute), "Invalid File", fmt.Sprintf("failed to hash file: %s", err))
+		return
+	}
+	resp.PlanValue = types.StringValue(hash)
+	if !req.StateValue.IsNull() && !req.StateValue.Equal(resp.PlanValue) {
+		resp.RequiresReplace = true
+	}
+}
+
+func fileSha256(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func requiresReplaceUnlessImportedIf(ctx context.Context, private privateStateGetter, stateIsNull bool) (bool, diag.Diagnostics) {
+	imported, diags := private.GetKey(ctx, importedPrivateStateKey)
+	return imported == nil || !stateIsNull, diags
+}
+
+// requiresReplaceUnlessImported allows setting a value that forgejo does not
+// return for the first time after an import without replacing the resource.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var diags diag.Diagnostics
+			resp.RequiresReplace, diags = requiresReplaceUnlessImportedIf(ctx, req.Private, req.StateValue.IsNull())
+			resp.Diagnostics.Append(diags...)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedBool() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			var diags diag.Diagnostics
+			resp.RequiresReplace, diags = requiresReplaceUnlessImportedIf(ctx, req.Private, req.StateValue.IsNull())
+			resp.Diagnostics.Append(diags...)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func requiresReplaceUnlessImportedInt64() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			var diags diag.Diagnostics
+			resp.RequiresReplace, diags = requiresReplaceUnlessImportedIf(ctx, req.Private, req.StateValue.IsNull())
+			resp.Diagnostics.Append(diags...)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+// sshPublicKeyRequiresReplace ignores changes to the comment of an SSH public
+// key, which forgejo strips.
+func sshPublicKeyRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = sshPublicKeyWithoutComment(req.StateValue.ValueString()) != sshPublicKeyWithoutComment(req.PlanValue.ValueString())
+		},
+		sshPublicKeyRequiresReplaceDescription,
+		sshPublicKeyRequiresReplaceDescription,
+	)
+}
+
+func sshPublicKeyWithoutComment(key string) string {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return key
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey)))
+}
+
+// teamUnitsMapPlanModifier keeps the units_map of a team computed by forgejo
+// unless its permission changes, which changes the permission of its units.
+type teamUnitsMapPlanModifier struct{}
+
+var _ planmodifier.Map = teamUnitsMapPlanModifier{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (m teamUnitsMapPlanModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the team's permission changes."
+}
+
+func (m teamUnitsMapPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m teamUnitsMapPlanModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var plannedPermission, statePermission types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permission"), &plannedPermission)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("permission"), &statePermission)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plannedPermission.Equal(statePermission) {
+		resp.PlanValue = req.StateValue
+	}
+}
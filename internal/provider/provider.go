@@ -8,6 +8,7 @@ import (
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,7 +19,8 @@ type Provider struct {
 	version string
 }
 
-var _ provider.Provider = &Provider{} // Ensure provider defined types fully satisfy framework interfaces.
+var _ provider.Provider = &Provider{}                       // Ensure provider defined types fully satisfy framework interfaces.
+var _ provider.ProviderWithEphemeralResources = &Provider{} // Ensure provider defined types fully satisfy framework interfaces.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &Provider{
@@ -30,6 +32,7 @@ func New(version string) func() provider.Provider {
 type ProviderModel struct {
 	ApiToken types.String `tfsdk:"api_token"`
 	BaseURI  types.String `tfsdk:"base_uri"`
+	Password types.String `tfsdk:"password"`
 }
 
 func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,6 +52,11 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				MarkdownDescription: "Forgejo's HTTP base URI.",
 				Required:            true,
 			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user whose access tokens are managed with the `forgejo_access_token` resource, since forgejo only allows managing access tokens with basic authentication. If not defined, the content of the environment variable `FORGEJO_PASSWORD` will be used instead.",
+				Optional:            true,
+				Sensitive:           true,
+			},
 		},
 	}
 }
@@ -75,34 +83,79 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	} else {
 		apiToken = data.ApiToken.ValueString()
 	}
-	client, err := client.NewClient(ctx, baseURI, apiToken)
+	password := os.Getenv("FORGEJO_PASSWORD")
+	if !data.Password.IsNull() {
+		password = data.Password.ValueString()
+	}
+	client, err := client.NewClient(ctx, baseURI, apiToken, password)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to instantiate forgejo client", err.Error())
 		return
 	}
 
 	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
 	resp.ResourceData = client
 }
 
+func (p *Provider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAccessTokenResource,
 		NewRepositoryActionsSecretResource,
 		NewRepositoryActionsVariableResource,
+		NewRepositoryBranchResource,
+		NewRepositoryDeployKeyResource,
+		NewRepositoryFileResource,
+		NewRepositoryFilesResource,
 		NewRepositoryLabelResource,
+		NewRepositoryLabelsResource,
+		NewOrganizationActionsSecretResource,
+		NewOrganizationActionsVariableResource,
+		NewOrganizationBlockedUserResource,
+		NewOrganizationLabelResource,
+		NewOrganizationPublicMembershipResource,
 		NewOrganizationResource,
+		NewOrganizationWebhookResource,
 		NewRepositoryPushMirrorResource,
+		NewRepositoryReleaseAttachmentResource,
+		NewRepositoryReleaseResource,
 		NewRepositoryResource,
+		NewRepositoryTagResource,
+		NewRepositoryWebhookResource,
+		NewSystemWebhookResource,
+		NewTeamRepositoryResource,
 		NewTeamResource,
+		NewUserActionsSecretResource,
+		NewUserActionsVariableResource,
+		NewUserBlockedUserResource,
+		NewUserGpgKeyResource,
+		NewUserResource,
+		NewUserSshKeyResource,
 	}
 }
 
 func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewOrganizationActionsSecretsDataSource,
+		NewOrganizationActionsVariablesDataSource,
+		NewOrganizationBlockedUsersDataSource,
 		NewOrganizationDataSource,
+		NewOrganizationLabelsDataSource,
+		NewOrganizationMembersDataSource,
 		NewOrganizationsDataSource,
 		NewRepositoriesDataSource,
+		NewRepositoryBranchesDataSource,
+		NewRepositoryDataSource,
+		NewTeamDataSource,
 		NewTeamsDataSource,
+		NewUserBlockedUsersDataSource,
+		NewUserDataSource,
 		NewUsersDataSource,
 	}
 }
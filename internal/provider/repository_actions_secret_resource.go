@@ -7,10 +7,14 @@ import (
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,17 +22,20 @@ type RepositoryActionsSecretResource struct {
 	client *client.Client
 }
 
-var _ resource.Resource = &RepositoryActionsSecretResource{} // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &RepositoryActionsSecretResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryActionsSecretResource{} // Ensure provider defined types fully satisfy framework interfaces
 func NewRepositoryActionsSecretResource() resource.Resource {
 	return &RepositoryActionsSecretResource{}
 }
 
 type RepositoryActionsSecretResourceModel struct {
-	CreatedAt  timetypes.RFC3339 `tfsdk:"created_at"`
-	Data       types.String      `tfsdk:"data"`
-	Name       types.String      `tfsdk:"name"`
-	Owner      types.String      `tfsdk:"owner"`
-	Repository types.String      `tfsdk:"repository"`
+	CreatedAt     timetypes.RFC3339 `tfsdk:"created_at"`
+	Data          types.String      `tfsdk:"data"`
+	DataWo        types.String      `tfsdk:"data_wo"`
+	DataWoVersion types.Int64       `tfsdk:"data_wo_version"`
+	Name          types.String      `tfsdk:"name"`
+	Owner         types.String      `tfsdk:"owner"`
+	Repository    types.String      `tfsdk:"repository"`
 }
 
 func (d *RepositoryActionsSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,9 +51,28 @@ func (d *RepositoryActionsSecretResource) Schema(ctx context.Context, req resour
 				MarkdownDescription: "The secret's creation date and time.",
 			},
 			"data": schema.StringAttribute{
-				MarkdownDescription: "The secret's data.",
-				Required:            true,
+				MarkdownDescription: "The secret's data. Since it cannot be read back from forgejo, it is null after an import and the next apply will set it. Exactly one of `data` and `data_wo` must be set.",
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data_wo")),
+				},
+			},
+			"data_wo": schema.StringAttribute{
+				MarkdownDescription: "The secret's data as a write-only attribute that is never stored in the terraform state. Requires terraform 1.11 or later. Since changes to it cannot be detected, bump `data_wo_version` to update the secret.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("data_wo_version")),
+				},
+				WriteOnly: true,
+			},
+			"data_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "An arbitrary version of the `data_wo` attribute. Changing it updates the secret with the current value of `data_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("data_wo")),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The secret's name. It must be uppercase or the plan will not be idempotent.",
@@ -78,9 +104,20 @@ func (d *RepositoryActionsSecretResource) Configure(ctx context.Context, req res
 	d.client, _ = req.ProviderData.(*client.Client)
 }
 
+// actionsSecretData returns the value of the write-only attribute when it is
+// configured, since it is never part of the plan.
+func actionsSecretData(data types.String, dataWo types.String) string {
+	if !dataWo.IsNull() {
+		return dataWo.ValueString()
+	}
+	return data.ValueString()
+}
+
 func (d *RepositoryActionsSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RepositoryActionsSecretResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var dataWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWo)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -89,7 +126,7 @@ func (d *RepositoryActionsSecretResource) Create(ctx context.Context, req resour
 		data.Owner.ValueString(),
 		data.Repository.ValueString(),
 		data.Name.ValueString(),
-		data.Data.ValueString())
+		actionsSecretData(data.Data, dataWo))
 	if err != nil {
 		resp.Diagnostics.AddError("CreateRepositoryActionsSecret", fmt.Sprintf("failed to create or update repository actions secret: %s", err))
 		return
@@ -146,6 +183,20 @@ func (d *RepositoryActionsSecretResource) getRepositoryActionsSecret(
 	return nil, fmt.Errorf("failed to find repository actions secret")
 }
 
+func (r *RepositoryActionsSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/secretName. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+}
+
 func (d *RepositoryActionsSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data RepositoryActionsSecretResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -165,6 +216,8 @@ func (d *RepositoryActionsSecretResource) Read(ctx context.Context, req resource
 func (d *RepositoryActionsSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data RepositoryActionsSecretResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var dataWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWo)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -173,7 +226,7 @@ func (d *RepositoryActionsSecretResource) Update(ctx context.Context, req resour
 		data.Owner.ValueString(),
 		data.Repository.ValueString(),
 		data.Name.ValueString(),
-		data.Data.ValueString())
+		actionsSecretData(data.Data, dataWo))
 	if err != nil {
 		resp.Diagnostics.AddError("UpdateRepositoryActionsSecret", fmt.Sprintf("failed to create or update repository actions secret: %s", err))
 		return
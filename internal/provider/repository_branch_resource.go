@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryBranchResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryBranchResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryBranchResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryBranchResource() resource.Resource {
+	return &RepositoryBranchResource{}
+}
+
+type RepositoryBranchResourceModel struct {
+	CommitSha  types.String `tfsdk:"commit_sha"`
+	Name       types.String `tfsdk:"name"`
+	OldRefName types.String `tfsdk:"old_ref_name"`
+	Owner      types.String `tfsdk:"owner"`
+	Protected  types.Bool   `tfsdk:"protected"`
+	Repository types.String `tfsdk:"repository"`
+}
+
+func (d *RepositoryBranchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_branch"
+}
+
+func (d *RepositoryBranchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA of the branch's head commit.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The branch's name. Changing it renames the branch.",
+				Required:            true,
+			},
+			"old_ref_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the branch or tag, or the SHA of the commit, to create the branch from. Defaults to the repository's default branch.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"protected": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the branch is protected or not.",
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a repository branch.",
+	}
+}
+
+func (d *RepositoryBranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryBranchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryBranchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	branch, err := d.client.RepositoryBranchCreate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Name.ValueString(),
+		data.OldRefName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryBranch", fmt.Sprintf("failed to create repository branch: %s", err))
+		return
+	}
+	populateRepositoryBranchResourceModel(&data, branch)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryBranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryBranchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.RepositoryBranchDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryBranch", fmt.Sprintf("failed to delete repository branch: %s", err))
+		return
+	}
+}
+
+func (r *RepositoryBranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/branch. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
+func populateRepositoryBranchResourceModel(data *RepositoryBranchResourceModel, branch *client.RepositoryBranch) {
+	if branch.Commit != nil {
+		data.CommitSha = types.StringValue(branch.Commit.Id)
+	} else {
+		data.CommitSha = types.StringNull()
+	}
+	data.Name = types.StringValue(branch.Name)
+	data.Protected = types.BoolValue(branch.Protected)
+}
+
+func (d *RepositoryBranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryBranchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	branch, err := d.client.RepositoryBranchGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Name.ValueString())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the branch was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryBranch", fmt.Sprintf("failed to get repository branch: %s", err))
+		return
+	}
+	populateRepositoryBranchResourceModel(&data, branch)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryBranchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData RepositoryBranchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryBranchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plannedData.Name.Equal(stateData.Name) {
+		err := d.client.RepositoryBranchRename(
+			ctx,
+			stateData.Owner.ValueString(),
+			stateData.Repository.ValueString(),
+			stateData.Name.ValueString(),
+			plannedData.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("UpdateRepositoryBranch", fmt.Sprintf("failed to rename repository branch: %s", err))
+			return
+		}
+	}
+	branch, err := d.client.RepositoryBranchGet(
+		ctx,
+		plannedData.Owner.ValueString(),
+		plannedData.Repository.ValueString(),
+		plannedData.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryBranch", fmt.Sprintf("failed to get repository branch: %s", err))
+		return
+	}
+	populateRepositoryBranchResourceModel(&plannedData, branch)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
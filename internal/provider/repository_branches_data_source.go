@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryBranchesDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &RepositoryBranchesDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryBranchesDataSource() datasource.DataSource {
+	return &RepositoryBranchesDataSource{}
+}
+
+type RepositoryBranchesDataSourceModel struct {
+	Elements   []RepositoryBranchDataSourceModel `tfsdk:"elements"`
+	Owner      types.String                      `tfsdk:"owner"`
+	Repository types.String                      `tfsdk:"repository"`
+}
+
+type RepositoryBranchDataSourceModel struct {
+	Commit                        *RepositoryBranchCommitDataSourceModel `tfsdk:"commit"`
+	EffectiveBranchProtectionName types.String                           `tfsdk:"effective_branch_protection_name"`
+	EnableStatusCheck             types.Bool                             `tfsdk:"enable_status_check"`
+	Name                          types.String                           `tfsdk:"name"`
+	Protected                     types.Bool                             `tfsdk:"protected"`
+	RequiredApprovals             types.Int64                            `tfsdk:"required_approvals"`
+	StatusCheckContexts           []types.String                         `tfsdk:"status_check_contexts"`
+	UserCanMerge                  types.Bool                             `tfsdk:"user_can_merge"`
+	UserCanPush                   types.Bool                             `tfsdk:"user_can_push"`
+}
+
+type RepositoryBranchCommitDataSourceModel struct {
+	Id        types.String      `tfsdk:"id"`
+	Message   types.String      `tfsdk:"message"`
+	Timestamp timetypes.RFC3339 `tfsdk:"timestamp"`
+	Url       types.String      `tfsdk:"url"`
+}
+
+func (d *RepositoryBranchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_branches"
+}
+
+func (d *RepositoryBranchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of branches of the repository.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The SHA of the commit.",
+								},
+								"message": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The commit's message.",
+								},
+								"timestamp": schema.StringAttribute{
+									Computed:            true,
+									CustomType:          timetypes.RFC3339Type{},
+									MarkdownDescription: "The commit's date and time.",
+								},
+								"url": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "The commit's URL.",
+								},
+							},
+							Computed:            true,
+							MarkdownDescription: "The branch's head commit.",
+						},
+						"effective_branch_protection_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the branch protection rule applying to the branch.",
+						},
+						"enable_status_check": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether status checks are required before merging into the branch or not.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The branch's name.",
+						},
+						"protected": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the branch is protected or not.",
+						},
+						"required_approvals": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of approvals required before merging into the branch.",
+						},
+						"status_check_contexts": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The list of status checks required before merging into the branch.",
+						},
+						"user_can_merge": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user the provider is authenticated as can merge into the branch or not.",
+						},
+						"user_can_push": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user the provider is authenticated as can push to the branch or not.",
+						},
+					},
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo repository branches.",
+	}
+}
+
+func (d *RepositoryBranchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryBranchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoryBranchesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	branches, err := d.client.RepositoryBranchesList(ctx, data.Owner.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ListRepositoryBranches", fmt.Sprintf("failed to list repository branches: %s", err))
+		return
+	}
+	data.Elements = make([]RepositoryBranchDataSourceModel, len(branches))
+	for i, branch := range branches {
+		data.Elements[i] = RepositoryBranchDataSourceModel{
+			EffectiveBranchProtectionName: types.StringValue(branch.EffectiveBranchProtectionName),
+			EnableStatusCheck:             types.BoolValue(branch.EnableStatusCheck),
+			Name:                          types.StringValue(branch.Name),
+			Protected:                     types.BoolValue(branch.Protected),
+			RequiredApprovals:             types.Int64Value(branch.RequiredApprovals),
+			StatusCheckContexts:           make([]types.String, len(branch.StatusCheckContexts)),
+			UserCanMerge:                  types.BoolValue(branch.UserCanMerge),
+			UserCanPush:                   types.BoolValue(branch.UserCanPush),
+		}
+		if branch.Commit != nil {
+			data.Elements[i].Commit = &RepositoryBranchCommitDataSourceModel{
+				Id:        types.StringValue(branch.Commit.Id),
+				Message:   types.StringValue(branch.Commit.Message),
+				Timestamp: timetypes.NewRFC3339TimeValue(branch.Commit.Timestamp),
+				Url:       types.StringValue(branch.Commit.Url),
+			}
+		}
+		for j, statusCheckContext := range branch.StatusCheckContexts {
+			data.Elements[i].StatusCheckContexts[j] = types.StringValue(statusCheckContext)
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &RepositoryDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryDataSource() datasource.DataSource {
+	return &RepositoryDataSource{}
+}
+
+type SingleRepositoryDataSourceModel struct {
+	RepositoryDataSourceModel
+	OwnerName types.String `tfsdk:"owner_name"`
+}
+
+func (d *RepositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (d *RepositoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := maps.Clone(repositoryDataSourceSchemaAttributes)
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the repository.",
+			Required:            true,
+		},
+		"owner_name": schema.StringAttribute{
+			MarkdownDescription: "The name of the user or organization owning the repository.",
+			Required:            true,
+		},
+	})
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Use this data source to retrieve information about an existing forgejo repository.",
+	}
+}
+
+func (d *RepositoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SingleRepositoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	repository, err := d.client.RepositoryGet(ctx, data.OwnerName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("RepositoryGet", fmt.Sprintf("failed to get repository: %s", err))
+		return
+	}
+	data.RepositoryDataSourceModel = *populateRepositoryDataSourceModel(repository)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryDeployKeyResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryDeployKeyResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryDeployKeyResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryDeployKeyResource() resource.Resource {
+	return &RepositoryDeployKeyResource{}
+}
+
+type RepositoryDeployKeyResourceModel struct {
+	CreatedAt   timetypes.RFC3339 `tfsdk:"created_at"`
+	Fingerprint types.String      `tfsdk:"fingerprint"`
+	Id          types.Int64       `tfsdk:"id"`
+	Key         types.String      `tfsdk:"key"`
+	Owner       types.String      `tfsdk:"owner"`
+	ReadOnly    types.Bool        `tfsdk:"read_only"`
+	Repository  types.String      `tfsdk:"repository"`
+	Title       types.String      `tfsdk:"title"`
+}
+
+func (d *RepositoryDeployKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_deploy_key"
+}
+
+func (d *RepositoryDeployKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The deploy key's creation date and time.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The deploy key's fingerprint.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the deploy key.",
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The deploy key's public key in the OpenSSH authorized_keys format.",
+				PlanModifiers:       []planmodifier.String{sshPublicKeyRequiresReplace()},
+				Required:            true,
+				Validators:          []validator.String{sshPublicKeyValidator{}},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository on which to configure a deploy key.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the deploy key only grants read access to the repository or not, defaults to `true`.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The repository on which to configure a deploy key.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The deploy key's title.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a repository deploy key.",
+	}
+}
+
+func (d *RepositoryDeployKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryDeployKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryDeployKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deployKey, err := d.client.RepositoryDeployKeyCreate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Title.ValueString(),
+		data.Key.ValueString(),
+		data.ReadOnly.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryDeployKey", fmt.Sprintf("failed to create repository deploy key: %s", err))
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(deployKey.CreatedAt)
+	data.Fingerprint = types.StringValue(deployKey.Fingerprint)
+	data.Id = types.Int64Value(deployKey.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryDeployKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryDeployKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.RepositoryDeployKeyDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryDeployKey", fmt.Sprintf("failed to delete repository deploy key: %s", err))
+		return
+	}
+}
+
+func (r *RepositoryDeployKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/deployKeyId. Got: %q", req.ID),
+		)
+		return
+	}
+	id, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected deploy key identifier to be an integer. Got: %q", idParts[2]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (d *RepositoryDeployKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryDeployKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deployKey, err := d.client.RepositoryDeployKeyGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the deploy key was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryDeployKey", fmt.Sprintf("failed to get repository deploy key: %s", err))
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(deployKey.CreatedAt)
+	data.Fingerprint = types.StringValue(deployKey.Fingerprint)
+	// forgejo strips the key's comment
+	if sshPublicKeyWithoutComment(data.Key.ValueString()) != sshPublicKeyWithoutComment(deployKey.Key) {
+		data.Key = types.StringValue(deployKey.Key)
+	}
+	data.ReadOnly = types.BoolValue(deployKey.ReadOnly)
+	data.Title = types.StringValue(deployKey.Title)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only reachable when changing the comment of the key, which forgejo strips.
+func (d *RepositoryDeployKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData RepositoryDeployKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryDeployKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateData.Key = plannedData.Key
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
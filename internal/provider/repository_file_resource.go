@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryFileResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryFileResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryFileResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryFileResource() resource.Resource {
+	return &RepositoryFileResource{}
+}
+
+type RepositoryFileResourceModel struct {
+	AuthorEmail       types.String `tfsdk:"author_email"`
+	AuthorName        types.String `tfsdk:"author_name"`
+	Branch            types.String `tfsdk:"branch"`
+	CommitMessage     types.String `tfsdk:"commit_message"`
+	CommitSha         types.String `tfsdk:"commit_sha"`
+	CommitterEmail    types.String `tfsdk:"committer_email"`
+	CommitterName     types.String `tfsdk:"committer_name"`
+	Content           types.String `tfsdk:"content"`
+	OverwriteOnCreate types.Bool   `tfsdk:"overwrite_on_create"`
+	Owner             types.String `tfsdk:"owner"`
+	Path              types.String `tfsdk:"path"`
+	Repository        types.String `tfsdk:"repository"`
+	Sha               types.String `tfsdk:"sha"`
+}
+
+func (d *RepositoryFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_file"
+}
+
+func (d *RepositoryFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the commits' author. Defaults to the user the provider is authenticated as.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("author_name")),
+				},
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the commits' author. Defaults to the user the provider is authenticated as.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("author_email")),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The branch to commit the file to. Defaults to the repository's default branch.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "The message of the commits creating, updating or deleting the file. Defaults to a message generated by forgejo.",
+				Optional:            true,
+			},
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA of the last commit that modified the file.",
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the commits' committer. Defaults to the author.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("committer_name")),
+				},
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the commits' committer. Defaults to the author.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("committer_email")),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The file's content. Only text files are supported.",
+				Required:            true,
+			},
+			"overwrite_on_create": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to overwrite the file if it already exists when creating the resource or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The file's path in the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"sha": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA of the file's blob.",
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a file in a repository.",
+	}
+}
+
+func (d *RepositoryFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Branch.IsUnknown() {
+		repository, err := d.client.RepositoryGet(ctx, data.Owner.ValueString(), data.Repository.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("CreateRepositoryFile", fmt.Sprintf("failed to get repository: %s", err))
+			return
+		}
+		data.Branch = types.StringValue(repository.DefaultBranch)
+	}
+	var existing *client.RepositoryFile
+	var err error
+	if data.OverwriteOnCreate.ValueBool() {
+		existing, err = d.client.RepositoryFileGet(
+			ctx,
+			data.Owner.ValueString(),
+			data.Repository.ValueString(),
+			data.Path.ValueString(),
+			data.Branch.ValueString())
+		// the file not existing is the expected case
+		if err != nil && !client.IsStatusCode(err, http.StatusNotFound) {
+			resp.Diagnostics.AddError("CreateRepositoryFile", fmt.Sprintf("failed to get repository file: %s", err))
+			return
+		}
+	}
+	var response *client.RepositoryFileResponse
+	if existing == nil {
+		request := client.RepositoryFileCreateRequest{
+			Author:    repositoryFileIdentity(data.AuthorName, data.AuthorEmail),
+			Branch:    data.Branch.ValueString(),
+			Committer: repositoryFileIdentity(data.CommitterName, data.CommitterEmail),
+			Content:   base64.StdEncoding.EncodeToString([]byte(data.Content.ValueString())),
+			Message:   data.CommitMessage.ValueString(),
+		}
+		response, err = d.client.RepositoryFileCreate(
+			ctx,
+			data.Owner.ValueString(),
+			data.Repository.ValueString(),
+			data.Path.ValueString(),
+			&request)
+	} else {
+		response, err = d.client.RepositoryFileUpdate(
+			ctx,
+			data.Owner.ValueString(),
+			data.Repository.ValueString(),
+			data.Path.ValueString(),
+			repositoryFileUpdateRequest(&data, existing.Sha))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryFile", fmt.Sprintf("failed to create repository file: %s", err))
+		return
+	}
+	data.CommitSha = types.StringValue(response.Commit.Sha)
+	data.Sha = types.StringValue(response.Content.Sha)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request := client.RepositoryFileDeleteRequest{
+		Author:    repositoryFileIdentity(data.AuthorName, data.AuthorEmail),
+		Branch:    data.Branch.ValueString(),
+		Committer: repositoryFileIdentity(data.CommitterName, data.CommitterEmail),
+		Message:   data.CommitMessage.ValueString(),
+		Sha:       data.Sha.ValueString(),
+	}
+	err := d.client.RepositoryFileDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Path.ValueString(),
+		&request)
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryFile", fmt.Sprintf("failed to delete repository file: %s", err))
+		return
+	}
+}
+
+func (r *RepositoryFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/path or owner/repository:branch/path. Got: %q", req.ID),
+		)
+		return
+	}
+	repository, branch, _ := strings.Cut(idParts[1], ":")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	if branch != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	}
+}
+
+func (d *RepositoryFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Branch.IsNull() {
+		repository, err := d.client.RepositoryGet(ctx, data.Owner.ValueString(), data.Repository.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("ReadRepositoryFile", fmt.Sprintf("failed to get repository: %s", err))
+			return
+		}
+		data.Branch = types.StringValue(repository.DefaultBranch)
+	}
+	if data.OverwriteOnCreate.IsNull() {
+		data.OverwriteOnCreate = types.BoolValue(false)
+	}
+	file, err := d.client.RepositoryFileGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Path.ValueString(),
+		data.Branch.ValueString())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the file was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryFile", fmt.Sprintf("failed to get repository file: %s", err))
+		return
+	}
+	if file.Type != "file" {
+		resp.Diagnostics.AddError("ReadRepositoryFile", fmt.Sprintf("repository path %q is a %s, not a file", file.Path, file.Type))
+		return
+	}
+	content, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryFile", fmt.Sprintf("failed to decode repository file content: %s", err))
+		return
+	}
+	data.CommitSha = types.StringValue(file.LastCommitSha)
+	data.Content = types.StringValue(string(content))
+	data.Sha = types.StringValue(file.Sha)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData RepositoryFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// only commit when the content changed, the other attributes apply to
+	// future commits
+	if plannedData.Content.Equal(stateData.Content) {
+		plannedData.CommitSha = stateData.CommitSha
+		plannedData.Sha = stateData.Sha
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+		return
+	}
+	response, err := d.client.RepositoryFileUpdate(
+		ctx,
+		plannedData.Owner.ValueString(),
+		plannedData.Repository.ValueString(),
+		plannedData.Path.ValueString(),
+		repositoryFileUpdateRequest(&plannedData, stateData.Sha.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryFile", fmt.Sprintf("failed to update repository file: %s", err))
+		return
+	}
+	plannedData.CommitSha = types.StringValue(response.Commit.Sha)
+	plannedData.Sha = types.StringValue(response.Content.Sha)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
+
+func repositoryFileIdentity(name types.String, email types.String) *client.Identity {
+	if name.IsNull() && email.IsNull() {
+		return nil
+	}
+	return &client.Identity{
+		Email: email.ValueString(),
+		Name:  name.ValueString(),
+	}
+}
+
+func repositoryFileUpdateRequest(data *RepositoryFileResourceModel, sha string) *client.RepositoryFileUpdateRequest {
+	return &client.RepositoryFileUpdateRequest{
+		Author:    repositoryFileIdentity(data.AuthorName, data.AuthorEmail),
+		Branch:    data.Branch.ValueString(),
+		Committer: repositoryFileIdentity(data.CommitterName, data.CommitterEmail),
+		Content:   base64.StdEncoding.EncodeToString([]byte(data.Content.ValueString())),
+		Message:   data.CommitMessage.ValueString(),
+		Sha:       sha,
+	}
+}
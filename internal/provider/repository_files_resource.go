@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryFilesResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryFilesResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryFilesResource() resource.Resource {
+	return &RepositoryFilesResource{}
+}
+
+type RepositoryFilesResourceModel struct {
+	AuthorEmail    types.String            `tfsdk:"author_email"`
+	AuthorName     types.String            `tfsdk:"author_name"`
+	Branch         types.String            `tfsdk:"branch"`
+	CommitMessage  types.String            `tfsdk:"commit_message"`
+	CommitSha      types.String            `tfsdk:"commit_sha"`
+	CommitterEmail types.String            `tfsdk:"committer_email"`
+	CommitterName  types.String            `tfsdk:"committer_name"`
+	Files          map[string]types.String `tfsdk:"files"`
+	NewBranch      types.String            `tfsdk:"new_branch"`
+	Owner          types.String            `tfsdk:"owner"`
+	Repository     types.String            `tfsdk:"repository"`
+	Shas           map[string]types.String `tfsdk:"shas"`
+}
+
+func (d *RepositoryFilesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_files"
+}
+
+func (d *RepositoryFilesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"author_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the commits' author. Defaults to the user the provider is authenticated as.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("author_name")),
+				},
+			},
+			"author_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the commits' author. Defaults to the user the provider is authenticated as.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("author_email")),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The branch to commit the files to, or to create `new_branch` from. Defaults to the repository's default branch.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "The message of the commits creating, updating or deleting the files. Defaults to a message generated by forgejo.",
+				Optional:            true,
+			},
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA of the last commit made by this resource. Null when the files already matched the repository's content.",
+			},
+			"committer_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the commits' committer. Defaults to the author.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("committer_name")),
+				},
+			},
+			"committer_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the commits' committer. Defaults to the author.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("committer_email")),
+				},
+			},
+			"files": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map of file paths in the repository to their content. Only text files are supported. Files removed from this map are deleted from the repository.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"new_branch": schema.StringAttribute{
+				MarkdownDescription: "The name of a branch to create from `branch` with the first commit. All subsequent commits are made to this new branch.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"shas": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map of file paths in the repository to the SHA of their blob.",
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a set of files in a repository with a single commit per change.",
+	}
+}
+
+func (d *RepositoryFilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+// commit sends the changes as a single commit then records the resulting blob
+// SHAs.
+func (d *RepositoryFilesResource) commit(ctx context.Context, data *RepositoryFilesResourceModel, changes []client.RepositoryFileChange, branch string, newBranch string) error {
+	request := client.RepositoryFilesChangeRequest{
+		Author:    repositoryFileIdentity(data.AuthorName, data.AuthorEmail),
+		Branch:    branch,
+		Committer: repositoryFileIdentity(data.CommitterName, data.CommitterEmail),
+		Files:     changes,
+		Message:   data.CommitMessage.ValueString(),
+		NewBranch: newBranch,
+	}
+	response, err := d.client.RepositoryFilesChange(ctx, data.Owner.ValueString(), data.Repository.ValueString(), &request)
+	if err != nil {
+		return err
+	}
+	data.CommitSha = types.StringValue(response.Commit.Sha)
+	for _, file := range response.Files {
+		if file != nil {
+			data.Shas[file.Path] = types.StringValue(file.Sha)
+		}
+	}
+	return nil
+}
+
+func (d *RepositoryFilesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryFilesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Branch.IsUnknown() {
+		repository, err := d.client.RepositoryGet(ctx, data.Owner.ValueString(), data.Repository.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("CreateRepositoryFiles", fmt.Sprintf("failed to get repository: %s", err))
+			return
+		}
+		data.Branch = types.StringValue(repository.DefaultBranch)
+	}
+	changes, err := d.diff(ctx, &data, data.Branch.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryFiles", fmt.Sprintf("failed to get repository files: %s", err))
+		return
+	}
+	data.CommitSha = types.StringNull()
+	if len(changes) > 0 {
+		if err := d.commit(ctx, &data, changes, data.Branch.ValueString(), data.NewBranch.ValueString()); err != nil {
+			resp.Diagnostics.AddError("CreateRepositoryFiles", fmt.Sprintf("failed to commit repository files: %s", err))
+			return
+		}
+	} else if !data.NewBranch.IsNull() {
+		resp.Diagnostics.AddError("CreateRepositoryFiles", "failed to create the new branch: the files already match the content of the branch so there is nothing to commit")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryFilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryFilesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	branch := repositoryFilesBranch(&data)
+	previous := data.Files
+	data.Files = nil
+	changes, err := d.diff(ctx, &data, branch, previous)
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryFiles", fmt.Sprintf("failed to get repository files: %s", err))
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+	if err := d.commit(ctx, &data, changes, branch, ""); err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryFiles", fmt.Sprintf("failed to delete repository files: %s", err))
+		return
+	}
+}
+
+// diff compares the planned files with the content of the branch. It returns
+// the changes to commit, including the deletion of previous files that are not
+// planned anymore, and records the blob SHAs of the files that are already up
+// to date.
+func (d *RepositoryFilesResource) diff(ctx context.Context, data *RepositoryFilesResourceModel, branch string, previous map[string]types.String) ([]client.RepositoryFileChange, error) {
+	var changes []client.RepositoryFileChange
+	data.Shas = make(map[string]types.String)
+	for _, filePath := range slices.Sorted(maps.Keys(data.Files)) {
+		content := data.Files[filePath].ValueString()
+		file, err := d.client.RepositoryFileGet(ctx, data.Owner.ValueString(), data.Repository.ValueString(), filePath, branch)
+		// the file not existing is an expected case
+		if err != nil && !client.IsStatusCode(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("failed to get repository file %q: %w", filePath, err)
+		}
+		if file == nil {
+			changes = append(changes, client.RepositoryFileChange{
+				Content:   base64.StdEncoding.EncodeToString([]byte(content)),
+				Operation: "create",
+				Path:      filePath,
+			})
+		} else if existing, err := base64.StdEncoding.DecodeString(file.Content); err == nil && string(existing) == content {
+			data.Shas[filePath] = types.StringValue(file.Sha)
+		} else {
+			changes = append(changes, client.RepositoryFileChange{
+				Content:   base64.StdEncoding.EncodeToString([]byte(content)),
+				Operation: "update",
+				Path:      filePath,
+				Sha:       file.Sha,
+			})
+		}
+	}
+	for _, filePath := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := data.Files[filePath]; ok {
+			continue
+		}
+		file, err := d.client.RepositoryFileGet(ctx, data.Owner.ValueString(), data.Repository.ValueString(), filePath, branch)
+		// the file already being gone is an expected case
+		if err != nil && !client.IsStatusCode(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("failed to get repository file %q: %w", filePath, err)
+		}
+		if file != nil {
+			changes = append(changes, client.RepositoryFileChange{
+				Operation: "delete",
+				Path:      filePath,
+				Sha:       file.Sha,
+			})
+		}
+	}
+	return changes, nil
+}
+
+func (d *RepositoryFilesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryFilesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	branch := repositoryFilesBranch(&data)
+	data.Shas = make(map[string]types.String)
+	for filePath := range data.Files {
+		file, err := d.client.RepositoryFileGet(ctx, data.Owner.ValueString(), data.Repository.ValueString(), filePath, branch)
+		if client.IsStatusCode(err, http.StatusNotFound) {
+			// the file was deleted outside of terraform
+			delete(data.Files, filePath)
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("ReadRepositoryFiles", fmt.Sprintf("failed to get repository file %q: %s", filePath, err))
+			return
+		}
+		content, err := base64.StdEncoding.DecodeString(file.Content)
+		if err != nil {
+			resp.Diagnostics.AddError("ReadRepositoryFiles", fmt.Sprintf("failed to decode repository file %q content: %s", filePath, err))
+			return
+		}
+		data.Files[filePath] = types.StringValue(string(content))
+		data.Shas[filePath] = types.StringValue(file.Sha)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func repositoryFilesBranch(data *RepositoryFilesResourceModel) string {
+	if data.NewBranch.IsNull() {
+		return data.Branch.ValueString()
+	}
+	return data.NewBranch.ValueString()
+}
+
+func (d *RepositoryFilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData RepositoryFilesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryFilesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	branch := repositoryFilesBranch(&stateData)
+	changes, err := d.diff(ctx, &plannedData, branch, stateData.Files)
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryFiles", fmt.Sprintf("failed to get repository files: %s", err))
+		return
+	}
+	plannedData.CommitSha = stateData.CommitSha
+	if len(changes) > 0 {
+		if err := d.commit(ctx, &plannedData, changes, branch, ""); err != nil {
+			resp.Diagnostics.AddError("UpdateRepositoryFiles", fmt.Sprintf("failed to commit repository files: %s", err))
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -17,7 +21,8 @@ type RepositoryLabelResource struct {
 	client *client.Client
 }
 
-var _ resource.Resource = &RepositoryLabelResource{} // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &RepositoryLabelResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryLabelResource{} // Ensure provider defined types fully satisfy framework interfaces
 func NewRepositoryLabelResource() resource.Resource {
 	return &RepositoryLabelResource{}
 }
@@ -140,6 +145,37 @@ func (d *RepositoryLabelResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r *RepositoryLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/labelName or owner/repository/labelId. Got: %q", req.ID),
+		)
+		return
+	}
+	labels, err := r.client.RepositoryLabelsList(ctx, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("ImportRepositoryLabel", fmt.Sprintf("failed to list repository labels: %s", err))
+		return
+	}
+	index := slices.IndexFunc(labels, func(label client.RepositoryLabel) bool {
+		return label.Name == idParts[2]
+	})
+	if id, err := strconv.ParseInt(idParts[2], 10, 64); index < 0 && err == nil {
+		index = slices.IndexFunc(labels, func(label client.RepositoryLabel) bool {
+			return label.Id == id
+		})
+	}
+	if index < 0 {
+		resp.Diagnostics.AddError("ImportRepositoryLabel", fmt.Sprintf("failed to find repository label %q", idParts[2]))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), labels[index].Id)...)
+}
+
 func (d *RepositoryLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data RepositoryLabelResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
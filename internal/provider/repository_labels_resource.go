@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryLabelsResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryLabelsResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryLabelsResource() resource.Resource {
+	return &RepositoryLabelsResource{}
+}
+
+type RepositoryLabelsResourceModel struct {
+	Authoritative types.Bool                                    `tfsdk:"authoritative"`
+	Labels        map[string]RepositoryLabelsResourceLabelModel `tfsdk:"labels"`
+	Owner         types.String                                  `tfsdk:"owner"`
+	Repository    types.String                                  `tfsdk:"repository"`
+}
+
+type RepositoryLabelsResourceLabelModel struct {
+	Color       types.String `tfsdk:"color"`
+	Description types.String `tfsdk:"description"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	Id          types.Int64  `tfsdk:"id"`
+	IsArchived  types.Bool   `tfsdk:"is_archived"`
+}
+
+func (d *RepositoryLabelsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_labels"
+}
+
+func (d *RepositoryLabelsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authoritative": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether labels of the repository that are not part of the `labels` map should be deleted or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"labels": schema.MapNestedAttribute{
+				MarkdownDescription: "The map of labels indexed by their names.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"color": schema.StringAttribute{
+							MarkdownDescription: "The label's color in lowercase rgb format, without a leading `#`. For example `207de5`.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+							MarkdownDescription: "A description string. Defaults to an empty string.",
+							Optional:            true,
+						},
+						"exclusive": schema.BoolAttribute{
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the label is exclusive or not. Defaults to `false`. Name the label `scope/item` to make it mutually exclusive with other `scope/` labels.",
+							Optional:            true,
+						},
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the repository label.",
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"is_archived": schema.BoolAttribute{
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the repository label is archived or not. Defaults to `false`",
+							Optional:            true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The labels' owner.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The labels' repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+		MarkdownDescription: "Use this resource to manage the set of labels of a repository. Labels that are removed from the configuration while others are added are renamed in place when they have the same color, non empty description and exclusivity, so that issues and pull requests keep them.",
+	}
+}
+
+func (d *RepositoryLabelsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryLabelsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := d.reconcileRepositoryLabels(ctx, &data, nil); err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryLabels", fmt.Sprintf("failed to reconcile repository labels: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryLabelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryLabelsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, label := range data.Labels {
+		err := d.client.RepositoryLabelDelete(
+			ctx,
+			data.Owner.ValueString(),
+			data.Repository.ValueString(),
+			label.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("DeleteRepositoryLabels", fmt.Sprintf("failed to delete repository label: %s", err))
+			return
+		}
+	}
+}
+
+// matchRepositoryLabelRenames pairs the labels to create with the labels to
+// delete that have the same color, description and exclusivity, and returns
+// the labels to rename indexed by their new name. Labels without a description
+// are never paired since that alone is not enough to tell them apart.
+func matchRepositoryLabelRenames(requests []client.RepositoryLabelCreateRequest, removable []*client.RepositoryLabel) map[string]*client.RepositoryLabel {
+	type candidate struct {
+		label *client.RepositoryLabel
+		name  string
+	}
+	var candidates []candidate
+	for _, request := range requests {
+		if request.Description == "" {
+			continue
+		}
+		for _, label := range removable {
+			if label.Color == request.Color &&
+				label.Description == request.Description &&
+				label.Exclusive == request.Exclusive {
+				candidates = append(candidates, candidate{label: label, name: request.Name})
+			}
+		}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(
+			strings.Compare(a.name, b.name),
+			cmp.Compare(a.label.Id, b.label.Id))
+	})
+	renames := make(map[string]*client.RepositoryLabel)
+	renamed := make(map[int64]bool)
+	for _, candidate := range candidates {
+		if _, ok := renames[candidate.name]; ok || renamed[candidate.label.Id] {
+			continue
+		}
+		renames[candidate.name] = candidate.label
+		renamed[candidate.label.Id] = true
+	}
+	return renames
+}
+
+func populateRepositoryLabelsResourceLabelModel(label *client.RepositoryLabel) RepositoryLabelsResourceLabelModel {
+	return RepositoryLabelsResourceLabelModel{
+		Color:       types.StringValue(label.Color),
+		Description: types.StringValue(label.Description),
+		Exclusive:   types.BoolValue(label.Exclusive),
+		Id:          types.Int64Value(label.Id),
+		IsArchived:  types.BoolValue(label.IsArchived),
+	}
+}
+
+func (d *RepositoryLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryLabelsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	labels, err := d.client.RepositoryLabelsList(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryLabels", fmt.Sprintf("failed to list repository labels: %s", err))
+		return
+	}
+	managedIds := make(map[int64]bool)
+	for _, label := range data.Labels {
+		managedIds[label.Id.ValueInt64()] = true
+	}
+	data.Labels = make(map[string]RepositoryLabelsResourceLabelModel)
+	for _, label := range labels {
+		if data.Authoritative.ValueBool() || managedIds[label.Id] {
+			data.Labels[label.Name] = populateRepositoryLabelsResourceLabelModel(&label)
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Labels that would be deleted are renamed instead when they match a label to
+// create, so that issues and pull requests keep them.
+func (d *RepositoryLabelsResource) reconcileRepositoryLabels(ctx context.Context, data *RepositoryLabelsResourceModel, managedIds map[int64]bool) error {
+	owner := data.Owner.ValueString()
+	repository := data.Repository.ValueString()
+	labels, err := d.client.RepositoryLabelsList(ctx, owner, repository)
+	if err != nil {
+		return fmt.Errorf("failed to list repository labels: %w", err)
+	}
+	labelsByName := make(map[string]*client.RepositoryLabel)
+	for i := range labels {
+		labelsByName[labels[i].Name] = &labels[i]
+	}
+	var missingNames []string
+	for name, planned := range data.Labels {
+		request := client.RepositoryLabelUpdateRequest{
+			Color:       planned.Color.ValueString(),
+			Description: planned.Description.ValueString(),
+			Exclusive:   planned.Exclusive.ValueBool(),
+			IsArchived:  planned.IsArchived.ValueBool(),
+			Name:        name,
+		}
+		existing, ok := labelsByName[name]
+		if !ok {
+			missingNames = append(missingNames, name)
+			continue
+		}
+		delete(labelsByName, name)
+		if !repositoryLabelAttributesMatch(existing, &request) {
+			if _, err := d.client.RepositoryLabelUpdate(ctx, owner, repository, existing.Id, &request); err != nil {
+				return fmt.Errorf("failed to update repository label %s: %w", name, err)
+			}
+		}
+		planned.Id = types.Int64Value(existing.Id)
+		data.Labels[name] = planned
+	}
+	var removable []*client.RepositoryLabel
+	for _, label := range labelsByName {
+		if data.Authoritative.ValueBool() || managedIds[label.Id] {
+			removable = append(removable, label)
+		}
+	}
+	slices.Sort(missingNames)
+	requests := make([]client.RepositoryLabelCreateRequest, len(missingNames))
+	for i, name := range missingNames {
+		planned := data.Labels[name]
+		requests[i] = client.RepositoryLabelCreateRequest{
+			Color:       planned.Color.ValueString(),
+			Description: planned.Description.ValueString(),
+			Exclusive:   planned.Exclusive.ValueBool(),
+			IsArchived:  planned.IsArchived.ValueBool(),
+			Name:        name,
+		}
+	}
+	renames := matchRepositoryLabelRenames(requests, removable)
+	for _, request := range requests {
+		var label *client.RepositoryLabel
+		if renamed, ok := renames[request.Name]; ok {
+			label, err = d.client.RepositoryLabelUpdate(ctx, owner, repository, renamed.Id, &request)
+			if err != nil {
+				return fmt.Errorf("failed to rename repository label %s to %s: %w", renamed.Name, request.Name, err)
+			}
+			removable = slices.DeleteFunc(removable, func(label *client.RepositoryLabel) bool {
+				return label == renamed
+			})
+		} else {
+			label, err = d.client.RepositoryLabelCreate(ctx, owner, repository, &request)
+			if err != nil {
+				return fmt.Errorf("failed to create repository label %s: %w", request.Name, err)
+			}
+		}
+		planned := data.Labels[request.Name]
+		planned.Id = types.Int64Value(label.Id)
+		data.Labels[request.Name] = planned
+	}
+	for _, label := range removable {
+		if err := d.client.RepositoryLabelDelete(ctx, owner, repository, label.Id); err != nil {
+			return fmt.Errorf("failed to delete repository label %s: %w", label.Name, err)
+		}
+	}
+	return nil
+}
+
+// repositoryLabelAttributesMatch compares everything but the label's name.
+func repositoryLabelAttributesMatch(label *client.RepositoryLabel, request *client.RepositoryLabelCreateRequest) bool {
+	return label.Color == request.Color &&
+		label.Description == request.Description &&
+		label.Exclusive == request.Exclusive &&
+		label.IsArchived == request.IsArchived
+}
+
+func (d *RepositoryLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData RepositoryLabelsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryLabelsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	managedIds := make(map[int64]bool)
+	for _, label := range stateData.Labels {
+		managedIds[label.Id.ValueInt64()] = true
+	}
+	if err := d.reconcileRepositoryLabels(ctx, &plannedData, managedIds); err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryLabels", fmt.Sprintf("failed to reconcile repository labels: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
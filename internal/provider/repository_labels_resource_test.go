@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+)
+
+func TestMatchRepositoryLabelRenames(t *testing.T) {
+	bug := &client.RepositoryLabel{Color: "ee0701", Description: "Something is not working", Id: 1, Name: "bug"}
+	feature := &client.RepositoryLabel{Color: "84b6eb", Description: "New functionality", Id: 2, Name: "feature"}
+	question := &client.RepositoryLabel{Color: "cc317c", Description: "Further information is requested", Exclusive: true, Id: 3, Name: "question"}
+	tests := []struct {
+		name      string
+		requests  []client.RepositoryLabelCreateRequest
+		removable []*client.RepositoryLabel
+		expected  map[string]int64
+	}{
+		{
+			name:     "nothing to rename",
+			requests: []client.RepositoryLabelCreateRequest{{Color: "ee0701", Description: "Something is not working", Name: "kind/bug"}},
+			expected: map[string]int64{},
+		},
+		{
+			name:      "identical attributes",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "ee0701", Description: "Something is not working", Name: "kind/bug"}},
+			removable: []*client.RepositoryLabel{bug, feature},
+			expected:  map[string]int64{"kind/bug": 1},
+		},
+		{
+			name:      "renamed while archived",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "ee0701", Description: "Something is not working", IsArchived: true, Name: "kind/bug"}},
+			removable: []*client.RepositoryLabel{bug},
+			expected:  map[string]int64{"kind/bug": 1},
+		},
+		{
+			name:      "same color only",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "ee0701", Description: "Regression", Name: "regression"}},
+			removable: []*client.RepositoryLabel{bug},
+			expected:  map[string]int64{},
+		},
+		{
+			name:      "same description only",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "000000", Description: "New functionality", Name: "kind/feature"}},
+			removable: []*client.RepositoryLabel{feature},
+			expected:  map[string]int64{},
+		},
+		{
+			name:      "different exclusivity",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "cc317c", Description: "Further information is requested", Name: "kind/question"}},
+			removable: []*client.RepositoryLabel{question},
+			expected:  map[string]int64{},
+		},
+		{
+			name:      "empty descriptions",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "ededed", Name: "wontfix"}},
+			removable: []*client.RepositoryLabel{{Color: "ededed", Id: 4, Name: "duplicate"}},
+			expected:  map[string]int64{},
+		},
+		{
+			name: "several renames",
+			requests: []client.RepositoryLabelCreateRequest{
+				{Color: "84b6eb", Description: "New functionality", Name: "kind/feature"},
+				{Color: "ee0701", Description: "Something is not working", Name: "kind/bug"},
+			},
+			removable: []*client.RepositoryLabel{feature, bug},
+			expected:  map[string]int64{"kind/bug": 1, "kind/feature": 2},
+		},
+		{
+			name: "each label is renamed once",
+			requests: []client.RepositoryLabelCreateRequest{
+				{Color: "ee0701", Description: "Something is not working", Name: "kind/bug"},
+				{Color: "ee0701", Description: "Something is not working", Name: "type/bug"},
+			},
+			removable: []*client.RepositoryLabel{bug},
+			expected:  map[string]int64{"kind/bug": 1},
+		},
+		{
+			name:      "nothing shared",
+			requests:  []client.RepositoryLabelCreateRequest{{Color: "000000", Description: "Unrelated", Name: "unrelated"}},
+			removable: []*client.RepositoryLabel{question},
+			expected:  map[string]int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renames := matchRepositoryLabelRenames(tt.requests, tt.removable)
+			if len(renames) != len(tt.expected) {
+				t.Fatalf("expected %d renames, got %d: %v", len(tt.expected), len(renames), renames)
+			}
+			for name, id := range tt.expected {
+				label, ok := renames[name]
+				if !ok {
+					t.Fatalf("expected %s to be renamed from label %d", name, id)
+				}
+				if label.Id != id {
+					t.Errorf("expected %s to be renamed from label %d, got %d", name, id, label.Id)
+				}
+			}
+		})
+	}
+}
@@ -3,9 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -13,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,22 +25,25 @@ type RepositoryPushMirrorResource struct {
 	client *client.Client
 }
 
-var _ resource.Resource = &RepositoryPushMirrorResource{} // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &RepositoryPushMirrorResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryPushMirrorResource{} // Ensure provider defined types fully satisfy framework interfaces
 func NewRepositoryPushMirrorResource() resource.Resource {
 	return &RepositoryPushMirrorResource{}
 }
 
 type RepositoryPushMirrorResourceModel struct {
-	Created        timetypes.RFC3339 `tfsdk:"created"`
-	Interval       types.String      `tfsdk:"interval"`
-	Name           types.String      `tfsdk:"name"`
-	Owner          types.String      `tfsdk:"owner"`
-	RemoteAddress  types.String      `tfsdk:"remote_address"`
-	RemotePassword types.String      `tfsdk:"remote_password"`
-	RemoteUsername types.String      `tfsdk:"remote_username"`
-	Repository     types.String      `tfsdk:"repository"`
-	SyncOnCommit   types.Bool        `tfsdk:"sync_on_commit"`
-	UseSsh         types.Bool        `tfsdk:"use_ssh"`
+	Created                 timetypes.RFC3339 `tfsdk:"created"`
+	Interval                types.String      `tfsdk:"interval"`
+	Name                    types.String      `tfsdk:"name"`
+	Owner                   types.String      `tfsdk:"owner"`
+	RemoteAddress           types.String      `tfsdk:"remote_address"`
+	RemotePassword          types.String      `tfsdk:"remote_password"`
+	RemotePasswordWo        types.String      `tfsdk:"remote_password_wo"`
+	RemotePasswordWoVersion types.Int64       `tfsdk:"remote_password_wo_version"`
+	RemoteUsername          types.String      `tfsdk:"remote_username"`
+	Repository              types.String      `tfsdk:"repository"`
+	SyncOnCommit            types.Bool        `tfsdk:"sync_on_commit"`
+	UseSsh                  types.Bool        `tfsdk:"use_ssh"`
 }
 
 func (d *RepositoryPushMirrorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,15 +80,29 @@ func (d *RepositoryPushMirrorResource) Schema(ctx context.Context, req resource.
 				Required:            true,
 			},
 			"remote_password": schema.StringAttribute{
-				MarkdownDescription: "The push mirror's remote password.",
+				MarkdownDescription: "The push mirror's remote password. Since it cannot be read back from forgejo, it is trusted to be correct after an import.",
 				Optional:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
 				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("remote_password_wo"))},
+			},
+			"remote_password_wo": schema.StringAttribute{
+				MarkdownDescription: "The push mirror's remote password as a write-only attribute that is never stored in the terraform state. Requires terraform 1.11 or later. Since changes to it cannot be detected, bump `remote_password_wo_version` to rotate it.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("remote_password_wo_version"))},
+				WriteOnly:           true,
+			},
+			"remote_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "An arbitrary version of the `remote_password_wo` attribute. Changing it recreates the push mirror with the current value of `remote_password_wo`, since forgejo does not allow updating push mirrors.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{requiresReplaceUnlessImportedInt64()},
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("remote_password_wo"))},
 			},
 			"remote_username": schema.StringAttribute{
-				MarkdownDescription: "The push mirror's remote username.",
+				MarkdownDescription: "The push mirror's remote username. Since it cannot be read back from forgejo, it is trusted to be correct after an import.",
 				Optional:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
 			},
 			"repository": schema.StringAttribute{
 				MarkdownDescription: "The repository on which to configure a push mirror.",
@@ -113,16 +135,22 @@ func (d *RepositoryPushMirrorResource) Configure(ctx context.Context, req resour
 func (d *RepositoryPushMirrorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RepositoryPushMirrorResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var remotePasswordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote_password_wo"), &remotePasswordWo)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	remotePassword := data.RemotePassword.ValueString()
+	if !remotePasswordWo.IsNull() {
+		remotePassword = remotePasswordWo.ValueString()
+	}
 	pushMirror, err := d.client.RepositoryPushMirrorCreate(
 		ctx,
 		data.Owner.ValueString(),
 		data.Repository.ValueString(),
 		data.Interval.ValueString(),
 		data.RemoteAddress.ValueString(),
-		data.RemotePassword.ValueString(),
+		remotePassword,
 		data.RemoteUsername.ValueString(),
 		data.SyncOnCommit.ValueBool(),
 		data.UseSsh.ValueBool())
@@ -170,6 +198,21 @@ func (d *RepositoryPushMirrorResource) getRepositoryPushMirror(
 	return pushMirror, nil
 }
 
+func (r *RepositoryPushMirrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/remoteName. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
 func (d *RepositoryPushMirrorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data RepositoryPushMirrorResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -183,9 +226,34 @@ func (d *RepositoryPushMirrorResource) Read(ctx context.Context, req resource.Re
 	}
 	data.Created = timetypes.NewRFC3339TimeValue(pushMirror.Created)
 	data.Name = types.StringValue(pushMirror.RemoteName)
+	// these attributes are only null after an import
+	if data.Interval.IsNull() {
+		data.Interval = types.StringValue(pushMirror.Interval)
+	}
+	if data.RemoteAddress.IsNull() {
+		data.RemoteAddress = types.StringValue(pushMirror.RemoteAddress)
+	}
+	if data.SyncOnCommit.IsNull() {
+		data.SyncOnCommit = types.BoolValue(pushMirror.SyncOnCommit)
+	}
+	if data.UseSsh.IsNull() {
+		data.UseSsh = types.BoolValue(pushMirror.PublicKey != "")
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Only reachable when setting the remote credentials of an imported push
+// mirror, which forgejo does not allow to read back.
 func (d *RepositoryPushMirrorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("UpdateRepositoryPushMirror", "unreachable code")
+	var plannedData RepositoryPushMirrorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryPushMirrorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plannedData.Created = stateData.Created
+	plannedData.Name = stateData.Name
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
 }
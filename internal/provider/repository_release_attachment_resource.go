@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryReleaseAttachmentResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryReleaseAttachmentResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryReleaseAttachmentResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryReleaseAttachmentResource() resource.Resource {
+	return &RepositoryReleaseAttachmentResource{}
+}
+
+type RepositoryReleaseAttachmentResourceModel struct {
+	BrowserDownloadUrl types.String      `tfsdk:"browser_download_url"`
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	DownloadCount      types.Int64       `tfsdk:"download_count"`
+	File               types.String      `tfsdk:"file"`
+	FileSha256         types.String      `tfsdk:"file_sha256"`
+	Id                 types.Int64       `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Owner              types.String      `tfsdk:"owner"`
+	ReleaseId          types.Int64       `tfsdk:"release_id"`
+	Repository         types.String      `tfsdk:"repository"`
+	Size               types.Int64       `tfsdk:"size"`
+	Uuid               types.String      `tfsdk:"uuid"`
+}
+
+func (d *RepositoryReleaseAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_release_attachment"
+}
+
+func (d *RepositoryReleaseAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"browser_download_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The attachment's download URL.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The attachment's upload date and time.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"download_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of times the attachment was downloaded.",
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path of the local file to upload.",
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
+				Required:            true,
+			},
+			"file_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA256 hash of the uploaded file. Changes to the content of `file` replace the attachment.",
+				PlanModifiers:       []planmodifier.String{fileSha256PlanModifier{attribute: "file"}},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the attachment.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The attachment's name. Defaults to the base name of `file`.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{fileBaseNamePlanModifier{attribute: "file"}},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"release_id": schema.Int64Attribute{
+				MarkdownDescription: "The identifier of the release.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The attachment's size in bytes.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The attachment's UUID.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		MarkdownDescription: "Use this resource to upload and manage a repository release attachment.",
+	}
+}
+
+func (d *RepositoryReleaseAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryReleaseAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryReleaseAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	file, err := os.Open(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryReleaseAttachment", fmt.Sprintf("failed to open file: %s", err))
+		return
+	}
+	defer file.Close()
+	attachment, err := d.client.RepositoryReleaseAttachmentCreate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.ReleaseId.ValueInt64(),
+		data.Name.ValueString(),
+		file)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryReleaseAttachment", fmt.Sprintf("failed to create repository release attachment: %s", err))
+		return
+	}
+	populateRepositoryReleaseAttachmentResourceModel(&data, attachment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryReleaseAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryReleaseAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.RepositoryReleaseAttachmentDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.ReleaseId.ValueInt64(),
+		data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryReleaseAttachment", fmt.Sprintf("failed to delete repository release attachment: %s", err))
+		return
+	}
+}
+
+func (r *RepositoryReleaseAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/releaseId/attachmentId. Got: %q", req.ID),
+		)
+		return
+	}
+	releaseId, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected release identifier to be an integer. Got: %q", idParts[2]),
+		)
+		return
+	}
+	id, err := strconv.ParseInt(idParts[3], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected attachment identifier to be an integer. Got: %q", idParts[3]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("release_id"), releaseId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
+func populateRepositoryReleaseAttachmentResourceModel(data *RepositoryReleaseAttachmentResourceModel, attachment *client.RepositoryReleaseAttachment) {
+	data.BrowserDownloadUrl = types.StringValue(attachment.BrowserDownloadUrl)
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(attachment.CreatedAt)
+	data.DownloadCount = types.Int64Value(attachment.DownloadCount)
+	data.Id = types.Int64Value(attachment.Id)
+	data.Name = types.StringValue(attachment.Name)
+	data.Size = types.Int64Value(attachment.Size)
+	data.Uuid = types.StringValue(attachment.Uuid)
+}
+
+func (d *RepositoryReleaseAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryReleaseAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attachment, err := d.client.RepositoryReleaseAttachmentGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.ReleaseId.ValueInt64(),
+		data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the attachment was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryReleaseAttachment", fmt.Sprintf("failed to get repository release attachment: %s", err))
+		return
+	}
+	populateRepositoryReleaseAttachmentResourceModel(&data, attachment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryReleaseAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData RepositoryReleaseAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData RepositoryReleaseAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var attachment *client.RepositoryReleaseAttachment
+	var err error
+	if plannedData.Name.Equal(stateData.Name) {
+		attachment, err = d.client.RepositoryReleaseAttachmentGet(
+			ctx,
+			plannedData.Owner.ValueString(),
+			plannedData.Repository.ValueString(),
+			plannedData.ReleaseId.ValueInt64(),
+			plannedData.Id.ValueInt64())
+	} else {
+		attachment, err = d.client.RepositoryReleaseAttachmentRename(
+			ctx,
+			plannedData.Owner.ValueString(),
+			plannedData.Repository.ValueString(),
+			plannedData.ReleaseId.ValueInt64(),
+			plannedData.Id.ValueInt64(),
+			plannedData.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryReleaseAttachment", fmt.Sprintf("failed to update repository release attachment: %s", err))
+		return
+	}
+	populateRepositoryReleaseAttachmentResourceModel(&plannedData, attachment)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryReleaseResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryReleaseResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryReleaseResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryReleaseResource() resource.Resource {
+	return &RepositoryReleaseResource{}
+}
+
+type RepositoryReleaseResourceModel struct {
+	Body             types.String      `tfsdk:"body"`
+	CreatedAt        timetypes.RFC3339 `tfsdk:"created_at"`
+	Draft            types.Bool        `tfsdk:"draft"`
+	HideArchiveLinks types.Bool        `tfsdk:"hide_archive_links"`
+	HtmlUrl          types.String      `tfsdk:"html_url"`
+	Id               types.Int64       `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Owner            types.String      `tfsdk:"owner"`
+	Prerelease       types.Bool        `tfsdk:"prerelease"`
+	PublishedAt      timetypes.RFC3339 `tfsdk:"published_at"`
+	Repository       types.String      `tfsdk:"repository"`
+	TagName          types.String      `tfsdk:"tag_name"`
+	TargetCommitish  types.String      `tfsdk:"target_commitish"`
+}
+
+func (d *RepositoryReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_release"
+}
+
+func (d *RepositoryReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"body": schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The release's notes. Defaults to an empty string.",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The release's creation date and time.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"draft": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the release is a draft or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"hide_archive_links": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to hide the source code archive links of the release or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"html_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The release's web page URL.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the release.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The release's title.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"prerelease": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the release is a pre-release or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"published_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The release's publication date and time, null for drafts.",
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"tag_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the release's tag. Forgejo creates the tag from `target_commitish` when it does not exist yet and the release is not a draft.",
+				Required:            true,
+			},
+			"target_commitish": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the branch, or the SHA of the commit, to create the tag from when it does not exist yet. Defaults to the repository's default branch.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a repository release. Destroying a release does not delete its tag.",
+	}
+}
+
+func (d *RepositoryReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	release, err := d.client.RepositoryReleaseCreate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		repositoryReleaseRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryRelease", fmt.Sprintf("failed to create repository release: %s", err))
+		return
+	}
+	populateRepositoryReleaseResourceModel(&data, release)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryReleaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.RepositoryReleaseDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryRelease", fmt.Sprintf("failed to delete repository release: %s", err))
+		return
+	}
+}
+
+func (r *RepositoryReleaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/releaseId. Got: %q", req.ID),
+		)
+		return
+	}
+	id, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected release identifier to be an integer. Got: %q", idParts[2]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func populateRepositoryReleaseResourceModel(data *RepositoryReleaseResourceModel, release *client.RepositoryRelease) {
+	data.Body = types.StringValue(release.Body)
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(release.CreatedAt)
+	data.Draft = types.BoolValue(release.Draft)
+	data.HideArchiveLinks = types.BoolValue(release.HideArchiveLinks)
+	data.HtmlUrl = types.StringValue(release.HtmlUrl)
+	data.Id = types.Int64Value(release.Id)
+	data.Name = types.StringValue(release.Name)
+	data.Prerelease = types.BoolValue(release.Prerelease)
+	if release.PublishedAt.IsZero() {
+		data.PublishedAt = timetypes.NewRFC3339Null()
+	} else {
+		data.PublishedAt = timetypes.NewRFC3339TimeValue(release.PublishedAt)
+	}
+	data.TagName = types.StringValue(release.TagName)
+	data.TargetCommitish = types.StringValue(release.TargetCommitish)
+}
+
+func (d *RepositoryReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryReleaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	release, err := d.client.RepositoryReleaseGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the release was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryRelease", fmt.Sprintf("failed to get repository release: %s", err))
+		return
+	}
+	populateRepositoryReleaseResourceModel(&data, release)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func repositoryReleaseRequest(data *RepositoryReleaseResourceModel) *client.RepositoryReleaseRequest {
+	return &client.RepositoryReleaseRequest{
+		Body:             data.Body.ValueString(),
+		Draft:            data.Draft.ValueBool(),
+		HideArchiveLinks: data.HideArchiveLinks.ValueBool(),
+		Name:             data.Name.ValueString(),
+		Prerelease:       data.Prerelease.ValueBool(),
+		TagName:          data.TagName.ValueString(),
+		TargetCommitish:  data.TargetCommitish.ValueString(),
+	}
+}
+
+func (d *RepositoryReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RepositoryReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	release, err := d.client.RepositoryReleaseUpdate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64(),
+		repositoryReleaseRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryRelease", fmt.Sprintf("failed to update repository release: %s", err))
+		return
+	}
+	populateRepositoryReleaseResourceModel(&data, release)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
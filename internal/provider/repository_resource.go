@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
@@ -29,6 +30,7 @@ func NewRepositoryResource() resource.Resource {
 }
 
 type RepositoryResourceModel struct {
+	AvatarResourceModel
 	CreatedAt       timetypes.RFC3339 `tfsdk:"created_at"`
 	DefaultBranch   types.String      `tfsdk:"default_branch"`
 	Description     types.String      `tfsdk:"description"`
@@ -148,6 +150,7 @@ func (d *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequ
 		},
 		MarkdownDescription: "Use this resource to create and manage a git repository.",
 	}
+	maps.Copy(resp.Schema.Attributes, avatarSchemaAttributes("repository"))
 }
 
 func (d *RepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -229,6 +232,25 @@ func (d *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	data.HasReleases = types.BoolValue(repository.HasReleases)
 	data.HasWiki = types.BoolValue(repository.HasWiki)
 	data.Owner = types.StringValue(repository.Owner.Login)
+	// the repository exists even if setting its avatar fails, the next apply retries it
+	avatar := data.AvatarResourceModel
+	data.AvatarSha256 = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AvatarResourceModel = avatar
+	err = updateAvatar(
+		&data.AvatarResourceModel,
+		&AvatarResourceModel{},
+		func(image string) error {
+			return d.client.RepositoryAvatarUpdate(ctx, repository.Owner.Login, repository.Name, image)
+		},
+		func() error { return d.client.RepositoryAvatarDelete(ctx, repository.Owner.Login, repository.Name) })
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepository", fmt.Sprintf("failed to set repository avatar: %s", err))
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -343,6 +365,17 @@ func (d *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		resp.Diagnostics.AddError("UpdateRepository", fmt.Sprintf("failed to update Repository: %s", err))
 		return
 	}
+	err = updateAvatar(
+		&plannedData.AvatarResourceModel,
+		&stateData.AvatarResourceModel,
+		func(image string) error {
+			return d.client.RepositoryAvatarUpdate(ctx, repository.Owner.Login, repository.Name, image)
+		},
+		func() error { return d.client.RepositoryAvatarDelete(ctx, repository.Owner.Login, repository.Name) })
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepository", fmt.Sprintf("failed to update repository avatar: %s", err))
+		return
+	}
 	plannedData.CreatedAt = timetypes.NewRFC3339TimeValue(repository.CreatedAt)
 	if plannedData.Description.IsUnknown() {
 		plannedData.Description = types.StringValue(repository.Description)
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryTagResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryTagResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryTagResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryTagResource() resource.Resource {
+	return &RepositoryTagResource{}
+}
+
+type RepositoryTagResourceModel struct {
+	CommitSha  types.String `tfsdk:"commit_sha"`
+	Id         types.String `tfsdk:"id"`
+	Message    types.String `tfsdk:"message"`
+	Name       types.String `tfsdk:"name"`
+	Owner      types.String `tfsdk:"owner"`
+	Repository types.String `tfsdk:"repository"`
+	Target     types.String `tfsdk:"target"`
+}
+
+func (d *RepositoryTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_tag"
+}
+
+func (d *RepositoryTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA of the commit the tag points to.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA of the tag object for annotated tags, or of the tagged commit for lightweight tags.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The tag's message. Setting it creates an annotated tag instead of a lightweight one.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The tag's name.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The owner of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The name of the branch, or the SHA of the commit, to tag. Defaults to the repository's default branch.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a repository tag.",
+	}
+}
+
+func (d *RepositoryTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tag, err := d.client.RepositoryTagCreate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Name.ValueString(),
+		data.Message.ValueString(),
+		data.Target.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryTag", fmt.Sprintf("failed to create repository tag: %s", err))
+		return
+	}
+	populateRepositoryTagResourceModel(&data, tag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.RepositoryTagDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryTag", fmt.Sprintf("failed to delete repository tag: %s", err))
+		return
+	}
+}
+
+func (r *RepositoryTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/tag. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
+func populateRepositoryTagResourceModel(data *RepositoryTagResourceModel, tag *client.RepositoryTag) {
+	// message and target are left alone: forgejo reports the commit message for
+	// lightweight tags and does not return the target the tag was created from
+	if tag.Commit != nil {
+		data.CommitSha = types.StringValue(tag.Commit.Sha)
+	} else {
+		data.CommitSha = types.StringNull()
+	}
+	data.Id = types.StringValue(tag.Id)
+	data.Name = types.StringValue(tag.Name)
+}
+
+func (d *RepositoryTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tag, err := d.client.RepositoryTagGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Name.ValueString())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the tag was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryTag", fmt.Sprintf("failed to get repository tag: %s", err))
+		return
+	}
+	populateRepositoryTagResourceModel(&data, tag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// only reached when setting the message or target for the first time after
+	// an import, which does not require any api call
+	var data RepositoryTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
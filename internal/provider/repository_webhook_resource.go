@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RepositoryWebhookResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &RepositoryWebhookResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &RepositoryWebhookResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewRepositoryWebhookResource() resource.Resource {
+	return &RepositoryWebhookResource{}
+}
+
+type RepositoryWebhookResourceModel struct {
+	WebhookResourceModel
+	Owner      types.String `tfsdk:"owner"`
+	Repository types.String `tfsdk:"repository"`
+}
+
+// WebhookResourceModel holds the attributes shared by all webhook resources.
+type WebhookResourceModel struct {
+	Active              types.Bool              `tfsdk:"active"`
+	AuthorizationHeader types.String            `tfsdk:"authorization_header"`
+	BranchFilter        types.String            `tfsdk:"branch_filter"`
+	Config              map[string]types.String `tfsdk:"config"`
+	Events              []types.String          `tfsdk:"events"`
+	Id                  types.Int64             `tfsdk:"id"`
+	Secret              types.String            `tfsdk:"secret"`
+	Type                types.String            `tfsdk:"type"`
+}
+
+var webhookEvents = []string{
+	"action_run_failure",
+	"action_run_recover",
+	"action_run_success",
+	"create",
+	"delete",
+	"fork",
+	"issue_assign",
+	"issue_comment",
+	"issue_label",
+	"issue_milestone",
+	"issues",
+	"package",
+	"pull_request",
+	"pull_request_assign",
+	"pull_request_comment",
+	"pull_request_label",
+	"pull_request_milestone",
+	"pull_request_review_approved",
+	"pull_request_review_comment",
+	"pull_request_review_rejected",
+	"pull_request_review_request",
+	"pull_request_sync",
+	"push",
+	"release",
+	"repository",
+	"wiki",
+}
+
+// forgejo enables these events when their group is enabled, and reports them
+// alongside it
+var webhookEventGroups = map[string][]string{
+	"issues": {
+		"issue_assign",
+		"issue_comment",
+		"issue_label",
+		"issue_milestone",
+	},
+	"pull_request": {
+		"pull_request_assign",
+		"pull_request_comment",
+		"pull_request_label",
+		"pull_request_milestone",
+		"pull_request_review_approved",
+		"pull_request_review_comment",
+		"pull_request_review_rejected",
+		"pull_request_review_request",
+		"pull_request_sync",
+	},
+}
+
+var webhookTypes = []string{
+	"dingtalk",
+	"discord",
+	"feishu",
+	"forgejo",
+	"gitea",
+	"matrix",
+	"msteams",
+	"packagist",
+	"slack",
+	"sourcehut_builds",
+	"telegram",
+	"wechatwork",
+}
+
+func (d *RepositoryWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_webhook"
+}
+
+// webhookSchemaAttributes returns a new map each time so that callers can add
+// their own scope attributes.
+func webhookSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"active": schema.BoolAttribute{
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Whether the webhook is active or not. Defaults to `true`.",
+			Optional:            true,
+		},
+		"authorization_header": schema.StringAttribute{
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+			MarkdownDescription: "The value of the `Authorization` header sent with each request. Defaults to an empty string.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"branch_filter": schema.StringAttribute{
+			Computed:            true,
+			Default:             stringdefault.StaticString("*"),
+			MarkdownDescription: "A glob pattern matching the branches whose events trigger the webhook. Defaults to `*`.",
+			Optional:            true,
+		},
+		"config": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "The webhook's configuration, for example `url`, `content_type` (`json` or `form`) and `http_method`. Use the `secret` attribute to set the webhook's secret.",
+			Required:            true,
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(stringvalidator.NoneOf("secret")),
+			},
+		},
+		"events": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("The set of events that trigger the webhook. Valid values are `%s`.", strings.Join(webhookEvents, "`, `")),
+			Required:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(webhookEvents...)),
+			},
+		},
+		"id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The identifier of the webhook.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"secret": schema.StringAttribute{
+			MarkdownDescription: "The webhook's secret used to sign its payloads. Since it cannot be read back from forgejo, changes made outside of terraform are not detected.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The webhook's type. Valid values are `%s`.", strings.Join(webhookTypes, "`, `")),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(webhookTypes...),
+			},
+		},
+	}
+}
+
+func (d *RepositoryWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := webhookSchemaAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "The owner of the repository on which to configure a webhook.",
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Required:            true,
+		},
+		"repository": schema.StringAttribute{
+			MarkdownDescription: "The repository on which to configure a webhook.",
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Required:            true,
+		},
+	})
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Use this resource to create and manage a repository webhook.",
+	}
+}
+
+func (d *RepositoryWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *RepositoryWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.RepositoryHookCreate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		webhookCreateRequest(&data.WebhookResourceModel))
+	if err != nil {
+		resp.Diagnostics.AddError("CreateRepositoryWebhook", fmt.Sprintf("failed to create repository webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.RepositoryHookDelete(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteRepositoryWebhook", fmt.Sprintf("failed to delete repository webhook: %s", err))
+		return
+	}
+}
+
+// expandWebhookEvents returns the sorted events forgejo enables for a set of
+// events.
+func expandWebhookEvents(events []types.String) []string {
+	var expanded []string
+	for _, event := range events {
+		expanded = append(expanded, event.ValueString())
+		expanded = append(expanded, webhookEventGroups[event.ValueString()]...)
+	}
+	slices.Sort(expanded)
+	return slices.Compact(expanded)
+}
+
+func (r *RepositoryWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: owner/repository/webhookId. Got: %q", req.ID),
+		)
+		return
+	}
+	id, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected webhook identifier to be an integer. Got: %q", idParts[2]),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Only the config keys already known are refreshed since forgejo adds its own
+// and omits the secret. Events are only refreshed when they differ from the
+// configured ones once expanded.
+func populateWebhookResourceModel(data *WebhookResourceModel, hook *client.Hook) {
+	data.Active = types.BoolValue(hook.Active)
+	data.AuthorizationHeader = types.StringValue(hook.AuthorizationHeader)
+	data.BranchFilter = types.StringValue(hook.BranchFilter)
+	if data.Config == nil {
+		data.Config = make(map[string]types.String)
+		for key, value := range hook.Config {
+			data.Config[key] = types.StringValue(value)
+		}
+	} else {
+		for key := range data.Config {
+			if value, ok := hook.Config[key]; ok {
+				data.Config[key] = types.StringValue(value)
+			}
+		}
+	}
+	events := slices.Clone(hook.Events)
+	slices.Sort(events)
+	if data.Events == nil || !slices.Equal(expandWebhookEvents(data.Events), slices.Compact(events)) {
+		data.Events = make([]types.String, len(hook.Events))
+		for i, event := range hook.Events {
+			data.Events[i] = types.StringValue(event)
+		}
+	}
+	data.Id = types.Int64Value(hook.Id)
+	data.Type = types.StringValue(hook.Type)
+}
+
+func (d *RepositoryWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.RepositoryHookGet(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the webhook was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadRepositoryWebhook", fmt.Sprintf("failed to get repository webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RepositoryWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RepositoryWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.RepositoryHookUpdate(
+		ctx,
+		data.Owner.ValueString(),
+		data.Repository.ValueString(),
+		data.Id.ValueInt64(),
+		webhookUpdateRequest(&data.WebhookResourceModel))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateRepositoryWebhook", fmt.Sprintf("failed to update repository webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func webhookCreateRequest(data *WebhookResourceModel) *client.HookCreateRequest {
+	request := webhookUpdateRequest(data)
+	return &client.HookCreateRequest{
+		Active:              request.Active,
+		AuthorizationHeader: request.AuthorizationHeader,
+		BranchFilter:        request.BranchFilter,
+		Config:              request.Config,
+		Events:              request.Events,
+		Type:                data.Type.ValueString(),
+	}
+}
+
+func webhookUpdateRequest(data *WebhookResourceModel) *client.HookUpdateRequest {
+	config := make(map[string]string)
+	for key, value := range data.Config {
+		config[key] = value.ValueString()
+	}
+	if !data.Secret.IsNull() {
+		config["secret"] = data.Secret.ValueString()
+	}
+	events := make([]string, len(data.Events))
+	for i, event := range data.Events {
+		events[i] = event.ValueString()
+	}
+	return &client.HookUpdateRequest{
+		Active:              data.Active.ValueBool(),
+		AuthorizationHeader: data.AuthorizationHeader.ValueString(),
+		BranchFilter:        data.BranchFilter.ValueString(),
+		Config:              config,
+		Events:              events,
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SystemWebhookResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &SystemWebhookResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &SystemWebhookResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewSystemWebhookResource() resource.Resource {
+	return &SystemWebhookResource{}
+}
+
+type SystemWebhookResourceModel struct {
+	WebhookResourceModel
+	IsSystemWebhook types.Bool `tfsdk:"is_system_webhook"`
+}
+
+func (d *SystemWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_webhook"
+}
+
+func (d *SystemWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := webhookSchemaAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"is_system_webhook": schema.BoolAttribute{
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Whether the webhook is a system webhook triggered by the events of all the repositories, or a default webhook copied to newly created repositories. Defaults to `true`. Since forgejo does not return it, it is trusted to be correct after an import.",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.Bool{requiresReplaceUnlessImportedBool()},
+		},
+	})
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Use this resource to create and manage a system webhook, triggered by the events of all the repositories of the forgejo instance, or a default webhook copied to newly created repositories. It requires the provider to be authenticated as an admin user.",
+	}
+}
+
+func (d *SystemWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+	if d.client != nil && !d.client.AuthenticatedAdmin() {
+		resp.Diagnostics.AddError(
+			"Insufficient Privileges",
+			fmt.Sprintf("Managing system webhooks requires the provider to be authenticated as an admin user, but %q is not an admin.", d.client.AuthenticatedUser()),
+		)
+	}
+}
+
+func (d *SystemWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.SystemHookCreate(
+		ctx,
+		webhookCreateRequest(&data.WebhookResourceModel),
+		data.IsSystemWebhook.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateSystemWebhook", fmt.Sprintf("failed to create system webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SystemWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := d.client.SystemHookDelete(ctx, data.Id.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("DeleteSystemWebhook", fmt.Sprintf("failed to delete system webhook: %s", err))
+		return
+	}
+}
+
+func (r *SystemWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: webhookId. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
+func (d *SystemWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.SystemHookGet(ctx, data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the webhook was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadSystemWebhook", fmt.Sprintf("failed to get system webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SystemWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hook, err := d.client.SystemHookUpdate(ctx, data.Id.ValueInt64(), webhookUpdateRequest(&data.WebhookResourceModel))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateSystemWebhook", fmt.Sprintf("failed to update system webhook: %s", err))
+		return
+	}
+	populateWebhookResourceModel(&data.WebhookResourceModel, hook)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
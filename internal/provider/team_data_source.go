@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &TeamDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+type SingleTeamDataSourceModel struct {
+	CanCreateOrgRepo        types.Bool              `tfsdk:"can_create_org_repo"`
+	Description             types.String            `tfsdk:"description"`
+	Id                      types.Int64             `tfsdk:"id"`
+	IncludesAllRepositories types.Bool              `tfsdk:"includes_all_repositories"`
+	Members                 []types.String          `tfsdk:"members"`
+	Name                    types.String            `tfsdk:"name"`
+	OrganizationName        types.String            `tfsdk:"organization_name"`
+	Permission              types.String            `tfsdk:"permission"`
+	Repositories            []types.String          `tfsdk:"repositories"`
+	Units                   []types.String          `tfsdk:"units"`
+	UnitsMap                map[string]types.String `tfsdk:"units_map"`
+}
+
+func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"can_create_org_repo": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether members of this team can create repositories that will belong to the organization.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A description string.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the team.",
+			},
+			"includes_all_repositories": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether members of this team can access all the repositories that belong to the organization.",
+			},
+			"members": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of logins of the team's members.",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The team's name.",
+				Required:            true,
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization the team is a part of.",
+				Required:            true,
+			},
+			"permission": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The members' permission level on the organization.",
+			},
+			"repositories": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of names of the repositories the team has access to.",
+			},
+			"units": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of units permissions.",
+			},
+			"units_map": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The map of units permissions and their level.",
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about an existing forgejo team.",
+	}
+}
+
+func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SingleTeamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	team, err := d.client.TeamGetByName(ctx, data.OrganizationName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("TeamGet", fmt.Sprintf("failed to get team: %s", err))
+		return
+	}
+	members, err := d.client.TeamMembersList(ctx, team.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("TeamGet", fmt.Sprintf("failed to list team members: %s", err))
+		return
+	}
+	repositories, err := d.client.TeamRepositoriesList(ctx, team.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("TeamGet", fmt.Sprintf("failed to list team repositories: %s", err))
+		return
+	}
+	data.CanCreateOrgRepo = types.BoolValue(team.CanCreateOrgRepo)
+	data.Description = types.StringValue(team.Description)
+	data.Id = types.Int64Value(team.Id)
+	data.IncludesAllRepositories = types.BoolValue(team.IncludesAllRepositories)
+	data.Name = types.StringValue(team.Name)
+	data.Permission = types.StringValue(team.Permission)
+	memberLogins := make([]string, len(members))
+	for i, member := range members {
+		memberLogins[i] = member.Login
+	}
+	slices.Sort(memberLogins)
+	data.Members = make([]types.String, len(memberLogins))
+	for i, login := range memberLogins {
+		data.Members[i] = types.StringValue(login)
+	}
+	repositoryNames := make([]string, len(repositories))
+	for i, repository := range repositories {
+		repositoryNames[i] = repository.Name
+	}
+	slices.Sort(repositoryNames)
+	data.Repositories = make([]types.String, len(repositoryNames))
+	for i, name := range repositoryNames {
+		data.Repositories[i] = types.StringValue(name)
+	}
+	slices.Sort(team.Units)
+	data.Units = make([]types.String, len(team.Units))
+	for i, unit := range team.Units {
+		data.Units[i] = types.StringValue(unit)
+	}
+	data.UnitsMap = make(map[string]types.String)
+	for unit, perm := range team.UnitsMap {
+		data.UnitsMap[unit] = types.StringValue(perm)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TeamRepositoryResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &TeamRepositoryResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &TeamRepositoryResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewTeamRepositoryResource() resource.Resource {
+	return &TeamRepositoryResource{}
+}
+
+type TeamRepositoryResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Repository       types.String `tfsdk:"repository"`
+	TeamId           types.Int64  `tfsdk:"team_id"`
+}
+
+func (d *TeamRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_repository"
+}
+
+func (d *TeamRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization owning both the team and the repository.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository the team is granted access to.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"team_id": schema.Int64Attribute{
+				MarkdownDescription: "The identifier of the team.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this resource to grant a team access to a repository of its organization. This is only useful for teams that do not include all repositories.",
+	}
+}
+
+func (d *TeamRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *TeamRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.TeamRepositoryAdd(
+		ctx,
+		data.TeamId.ValueInt64(),
+		data.OrganizationName.ValueString(),
+		data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateTeamRepository", fmt.Sprintf("failed to add repository to team: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *TeamRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamRepositoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.TeamRepositoryDelete(
+		ctx,
+		data.TeamId.ValueInt64(),
+		data.OrganizationName.ValueString(),
+		data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteTeamRepository", fmt.Sprintf("failed to remove repository from team: %s", err))
+		return
+	}
+}
+
+func (r *TeamRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/team/repository. Got: %q", req.ID),
+		)
+		return
+	}
+	team, err := r.client.RepositoryTeamGet(ctx, idParts[0], idParts[2], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("ImportTeamRepository", fmt.Sprintf("failed to get repository team: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), team.Id)...)
+}
+
+func (d *TeamRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamRepositoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	repository, err := d.client.TeamRepositoryGet(
+		ctx,
+		data.TeamId.ValueInt64(),
+		data.OrganizationName.ValueString(),
+		data.Repository.ValueString())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the repository was removed from the team outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadTeamRepository", fmt.Sprintf("failed to get team repository: %s", err))
+		return
+	}
+	data.Repository = types.StringValue(repository.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *TeamRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UpdateTeamRepository", "unreachable code")
+}
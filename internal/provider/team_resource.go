@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,8 +24,9 @@ type TeamResource struct {
 	client *client.Client
 }
 
-var _ resource.Resource = &TeamResource{}                // Ensure provider defined types fully satisfy framework interfaces
-var _ resource.ResourceWithImportState = &TeamResource{} // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &TeamResource{}                   // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &TeamResource{}    // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithValidateConfig = &TeamResource{} // Ensure provider defined types fully satisfy framework interfaces
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
 }
@@ -35,6 +39,20 @@ type TeamResourceModel struct {
 	Name                    types.String `tfsdk:"name"`
 	OrganizationName        types.String `tfsdk:"organization_name"`
 	Permission              types.String `tfsdk:"permission"`
+	UnitsMap                types.Map    `tfsdk:"units_map"`
+}
+
+var teamUnits = []string{
+	"repo.actions",
+	"repo.code",
+	"repo.ext_issues",
+	"repo.ext_wiki",
+	"repo.issues",
+	"repo.packages",
+	"repo.projects",
+	"repo.pulls",
+	"repo.releases",
+	"repo.wiki",
 }
 
 func (d *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,6 +101,19 @@ func (d *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringvalidator.OneOf("admin", "read", "write"),
 				},
 			},
+			"units_map": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The map of units permissions and their level. Valid keys are `repo.actions`, `repo.code`, `repo.ext_issues`, `repo.ext_wiki`, `repo.issues`, `repo.packages`, `repo.projects`, `repo.pulls`, `repo.releases` and `repo.wiki`. Valid values are `none`, `read` and `write`, except for `repo.ext_issues` and `repo.ext_wiki` which only support `none` and `read`. If unset, members are granted the team's permission level on all units. Cannot be set when permission is `admin`. Forgejo lowers the team's permission level to the smallest level of this map.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					teamUnitsMapPlanModifier{},
+				},
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(teamUnits...)),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("none", "read", "write")),
+				},
+			},
 		},
 		MarkdownDescription: "Use this resource to create and manage a team.",
 	}
@@ -107,19 +138,9 @@ func (d *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if !data.Description.IsUnknown() {
 		request.Description = data.Description.ValueString()
 	}
-	if data.Permission.ValueString() == "write" {
-		request.Units = []string{
-			"repo.code",
-			"repo.issues",
-			"repo.ext_issues",
-			"repo.releases",
-			"repo.wiki",
-			"repo.ext_wiki",
-			"repo.packages",
-			"repo.pulls",
-			"repo.projects",
-			"repo.actions",
-		}
+	resp.Diagnostics.Append(populateTeamRequestUnits(ctx, &request, data.UnitsMap)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	team, err := d.client.TeamCreate(
 		ctx,
@@ -135,7 +156,10 @@ func (d *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	data.IncludesAllRepositories = types.BoolValue(team.IncludesAllRepositories)
 	data.Name = types.StringValue(team.Name)
 	data.OrganizationName = types.StringValue(team.Organization.Name)
-	data.Permission = types.StringValue(team.Permission)
+	data.Permission = teamPermissionValue(data.Permission, team.Permission)
+	unitsMap, diags := types.MapValueFrom(ctx, types.StringType, team.UnitsMap)
+	resp.Diagnostics.Append(diags...)
+	data.UnitsMap = unitsMap
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -155,14 +179,38 @@ func (d *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) == 2 && idParts[0] != "" && idParts[1] != "" {
+		team, err := r.client.TeamGetByName(ctx, idParts[0], idParts[1])
+		if err != nil {
+			resp.Diagnostics.AddError("ImportTeam", fmt.Sprintf("failed to get team: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), team.Id)...)
+		return
+	}
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		resp.Diagnostics.AddError("ImportTeam", fmt.Sprintf("failed to parse int64 id: %s", err))
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with either format <team_id> or format <organization>/<team_name>. Got: %q", req.ID),
+		)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
+func populateTeamRequestUnits(ctx context.Context, request *client.TeamRequest, unitsMap types.Map) diag.Diagnostics {
+	if request.Permission == "admin" {
+		return nil
+	}
+	if unitsMap.IsNull() || unitsMap.IsUnknown() {
+		request.Units = teamUnits
+		return nil
+	}
+	return unitsMap.ElementsAs(ctx, &request.UnitsMap, false)
+}
+
 func (d *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data TeamResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -182,10 +230,22 @@ func (d *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.IncludesAllRepositories = types.BoolValue(team.IncludesAllRepositories)
 	data.Name = types.StringValue(team.Name)
 	data.OrganizationName = types.StringValue(team.Organization.Name)
-	data.Permission = types.StringValue(team.Permission)
+	data.Permission = teamPermissionValue(data.Permission, team.Permission)
+	unitsMap, diags := types.MapValueFrom(ctx, types.StringType, team.UnitsMap)
+	resp.Diagnostics.Append(diags...)
+	data.UnitsMap = unitsMap
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func teamPermissionValue(current types.String, permission string) types.String {
+	// forgejo lowers the permission of a team to the smallest permission of its
+	// units_map, only report changes to and from the admin permission
+	if !current.IsNull() && !current.IsUnknown() && current.ValueString() != "admin" && permission != "admin" {
+		return current
+	}
+	return types.StringValue(permission)
+}
+
 func (d *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plannedData TeamResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
@@ -203,19 +263,9 @@ func (d *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !plannedData.Description.IsUnknown() {
 		request.Description = plannedData.Description.ValueString()
 	}
-	if plannedData.Permission.ValueString() != "admin" {
-		request.Units = []string{
-			"repo.code",
-			"repo.issues",
-			"repo.ext_issues",
-			"repo.releases",
-			"repo.wiki",
-			"repo.ext_wiki",
-			"repo.packages",
-			"repo.pulls",
-			"repo.projects",
-			"repo.actions",
-		}
+	resp.Diagnostics.Append(populateTeamRequestUnits(ctx, &request, plannedData.UnitsMap)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	team, err := d.client.TeamUpdate(
 		ctx,
@@ -231,6 +281,39 @@ func (d *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	plannedData.IncludesAllRepositories = types.BoolValue(team.IncludesAllRepositories)
 	plannedData.Name = types.StringValue(team.Name)
 	// forgejo does not set team.Organization on PATCH API calls
-	plannedData.Permission = types.StringValue(team.Permission)
+	plannedData.Permission = teamPermissionValue(plannedData.Permission, team.Permission)
+	unitsMap, diags := types.MapValueFrom(ctx, types.StringType, team.UnitsMap)
+	resp.Diagnostics.Append(diags...)
+	plannedData.UnitsMap = unitsMap
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
 }
+
+func (d *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Permission.ValueString() == "admin" && !data.UnitsMap.IsNull() && !data.UnitsMap.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("units_map"),
+			"Invalid Attribute Combination",
+			"units_map cannot be set when permission is admin.",
+		)
+	}
+	if data.UnitsMap.IsNull() || data.UnitsMap.IsUnknown() {
+		return
+	}
+	var unitsMap map[string]types.String
+	resp.Diagnostics.Append(data.UnitsMap.ElementsAs(ctx, &unitsMap, false)...)
+	// forgejo only supports reading external issue trackers and wikis
+	for _, unit := range []string{"repo.ext_issues", "repo.ext_wiki"} {
+		if unitsMap[unit].ValueString() == "write" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("units_map").AtMapKey(unit),
+				"Invalid Attribute Value",
+				fmt.Sprintf("%s only supports the none and read permissions.", unit),
+			)
+		}
+	}
+}
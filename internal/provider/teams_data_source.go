@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 
 	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
@@ -21,8 +22,8 @@ func NewTeamsDataSource() datasource.DataSource {
 }
 
 type TeamsDataSourceModel struct {
-	Elements         []TeamDataSourceModel `tfsdk:"elements"`
-	OrganizationName types.String          `tfsdk:"organization_name"`
+	Elements         []TeamWithRepositoriesDataSourceModel `tfsdk:"elements"`
+	OrganizationName types.String                          `tfsdk:"organization_name"`
 }
 
 type TeamDataSourceModel struct {
@@ -38,6 +39,11 @@ type TeamDataSourceModel struct {
 	UnitsMap   map[string]types.String `tfsdk:"units_map"`
 }
 
+type TeamWithRepositoriesDataSourceModel struct {
+	TeamDataSourceModel
+	Repositories []types.String `tfsdk:"repositories"`
+}
+
 func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_teams"
 }
@@ -86,9 +92,16 @@ var teamSchemaAttributes = schema.ListNestedAttribute{
 }
 
 func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	elements := teamSchemaAttributes
+	elements.NestedObject.Attributes = maps.Clone(teamSchemaAttributes.NestedObject.Attributes)
+	elements.NestedObject.Attributes["repositories"] = schema.ListAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The list of names of the repositories the team has access to.",
+	}
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"elements": teamSchemaAttributes,
+			"elements": elements,
 			"organization_name": schema.StringAttribute{
 				MarkdownDescription: "The name of the organization the teams are a part of.",
 				Required:            true,
@@ -126,9 +139,9 @@ func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddError("ListTeams", fmt.Sprintf("failed to list teams: %s", err))
 		return
 	}
-	teamsList := make([]TeamDataSourceModel, len(teams))
+	teamsList := make([]TeamWithRepositoriesDataSourceModel, len(teams))
 	for i, team := range teams {
-		teamsList[i] = *populateTeamDataSourceModel(&team)
+		teamsList[i].TeamDataSourceModel = *populateTeamDataSourceModel(&team)
 		slices.Sort(team.Units)
 		for j, unit := range team.Units {
 			teamsList[i].Units[j] = types.StringValue(unit)
@@ -136,6 +149,20 @@ func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		for unit, perm := range team.UnitsMap {
 			teamsList[i].UnitsMap[unit] = types.StringValue(perm)
 		}
+		repositories, err := d.client.TeamRepositoriesList(ctx, team.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("ListTeams", fmt.Sprintf("failed to list team repositories: %s", err))
+			return
+		}
+		repositoryNames := make([]string, len(repositories))
+		for j, repository := range repositories {
+			repositoryNames[j] = repository.Name
+		}
+		slices.Sort(repositoryNames)
+		teamsList[i].Repositories = make([]types.String, len(repositoryNames))
+		for j, name := range repositoryNames {
+			teamsList[i].Repositories[j] = types.StringValue(name)
+		}
 	}
 	data.Elements = teamsList
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserActionsSecretResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &UserActionsSecretResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &UserActionsSecretResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserActionsSecretResource() resource.Resource {
+	return &UserActionsSecretResource{}
+}
+
+type UserActionsSecretResourceModel struct {
+	Data          types.String `tfsdk:"data"`
+	DataWo        types.String `tfsdk:"data_wo"`
+	DataWoVersion types.Int64  `tfsdk:"data_wo_version"`
+	Name          types.String `tfsdk:"name"`
+}
+
+func (d *UserActionsSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_actions_secret"
+}
+
+func (d *UserActionsSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.StringAttribute{
+				MarkdownDescription: "The secret's data. Since it cannot be read back from forgejo, it is null after an import and the next apply will set it. Exactly one of `data` and `data_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data_wo")),
+				},
+			},
+			"data_wo": schema.StringAttribute{
+				MarkdownDescription: "The secret's data as a write-only attribute that is never stored in the terraform state. Requires terraform 1.11 or later. Since changes to it cannot be detected, bump `data_wo_version` to update the secret.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("data_wo_version")),
+				},
+				WriteOnly: true,
+			},
+			"data_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "An arbitrary version of the `data_wo` attribute. Changing it updates the secret with the current value of `data_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("data_wo")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The secret's name. It must be uppercase or the plan will not be idempotent.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage an actions secret of the authenticated user. Since forgejo does not allow listing user secrets, changes made outside of terraform cannot be detected and imports do not check that the secret exists.",
+	}
+}
+
+func (d *UserActionsSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserActionsSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserActionsSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var dataWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserActionsSecretCreateOrUpdate(
+		ctx,
+		data.Name.ValueString(),
+		actionsSecretData(data.Data, dataWo))
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserActionsSecret", fmt.Sprintf("failed to create or update user actions secret: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserActionsSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserActionsSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserActionsSecretDelete(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteUserActionsSecret", fmt.Sprintf("failed to delete user actions secret: %s", err))
+		return
+	}
+}
+
+func (r *UserActionsSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (d *UserActionsSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserActionsSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserActionsSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserActionsSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	var dataWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &dataWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserActionsSecretCreateOrUpdate(
+		ctx,
+		data.Name.ValueString(),
+		actionsSecretData(data.Data, dataWo))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateUserActionsSecret", fmt.Sprintf("failed to create or update user actions secret: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserActionsVariableResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &UserActionsVariableResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &UserActionsVariableResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserActionsVariableResource() resource.Resource {
+	return &UserActionsVariableResource{}
+}
+
+type UserActionsVariableResourceModel struct {
+	Data types.String `tfsdk:"data"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *UserActionsVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_actions_variable"
+}
+
+func (d *UserActionsVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data": schema.StringAttribute{
+				MarkdownDescription: "The variable's data.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The variable's name. It must be uppercase or the plan will not be idempotent.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage an actions variable of the authenticated user.",
+	}
+}
+
+func (d *UserActionsVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserActionsVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserActionsVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserActionsVariableCreate(
+		ctx,
+		data.Name.ValueString(),
+		data.Data.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserActionsVariable", fmt.Sprintf("failed to create user actions variable: %s\nTry importing the resource instead?", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserActionsVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserActionsVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserActionsVariableDelete(
+		ctx,
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteUserActionsVariable", fmt.Sprintf("failed to delete user actions variable: %s", err))
+		return
+	}
+}
+
+func (r *UserActionsVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (d *UserActionsVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserActionsVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	variable, err := d.client.UserActionsVariableGet(
+		ctx,
+		data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadUserActionsVariable", fmt.Sprintf("failed to get user actions variable: %s", err))
+		return
+	}
+	data.Data = types.StringValue(variable.Data)
+	data.Name = types.StringValue(variable.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserActionsVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData UserActionsVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData UserActionsVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserActionsVariableUpdate(
+		ctx,
+		stateData.Name.ValueString(),
+		plannedData.Name.ValueString(),
+		plannedData.Data.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateUserActionsVariable", fmt.Sprintf("failed to update user actions variable: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
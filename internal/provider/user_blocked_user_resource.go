@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserBlockedUserResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &UserBlockedUserResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &UserBlockedUserResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserBlockedUserResource() resource.Resource {
+	return &UserBlockedUserResource{}
+}
+
+type UserBlockedUserResourceModel struct {
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
+	UserId    types.Int64       `tfsdk:"user_id"`
+	Username  types.String      `tfsdk:"username"`
+}
+
+func (d *UserBlockedUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_blocked_user"
+}
+
+func (d *UserBlockedUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The date and time at which the user was blocked.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the blocked user.",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The login of the blocked user.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "Use this resource to block a user from interacting with the user the provider is authenticated as.",
+	}
+}
+
+func (d *UserBlockedUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserBlockedUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserBlockedUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	user, err := d.client.UserGet(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserBlockedUser", fmt.Sprintf("failed to get user: %s", err))
+		return
+	}
+	err = d.client.UserBlockedUserAdd(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserBlockedUser", fmt.Sprintf("failed to block user: %s", err))
+		return
+	}
+	blockedUsers, err := d.client.UserBlockedUsersList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserBlockedUser", fmt.Sprintf("failed to list blocked users: %s", err))
+		return
+	}
+	index := slices.IndexFunc(blockedUsers, func(blockedUser client.BlockedUser) bool {
+		return blockedUser.BlockId == user.Id
+	})
+	if index < 0 {
+		resp.Diagnostics.AddError("CreateUserBlockedUser", "failed to find the blocked user after blocking it")
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(blockedUsers[index].CreatedAt)
+	data.UserId = types.Int64Value(user.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserBlockedUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserBlockedUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := d.client.UserBlockedUserDelete(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteUserBlockedUser", fmt.Sprintf("failed to unblock user: %s", err))
+		return
+	}
+}
+
+func (r *UserBlockedUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	user, err := r.client.UserGet(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("ImportUserBlockedUser", fmt.Sprintf("failed to get user: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), user.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), user.Login)...)
+}
+
+func (d *UserBlockedUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserBlockedUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blockedUsers, err := d.client.UserBlockedUsersList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("ReadUserBlockedUser", fmt.Sprintf("failed to list blocked users: %s", err))
+		return
+	}
+	index := slices.IndexFunc(blockedUsers, func(blockedUser client.BlockedUser) bool {
+		return blockedUser.BlockId == data.UserId.ValueInt64()
+	})
+	if index < 0 {
+		// the user was unblocked outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(blockedUsers[index].CreatedAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserBlockedUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("UpdateUserBlockedUser", "unreachable code")
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+type UserBlockedUsersDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &UserBlockedUsersDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserBlockedUsersDataSource() datasource.DataSource {
+	return &UserBlockedUsersDataSource{}
+}
+
+type UserBlockedUsersDataSourceModel struct {
+	Elements []BlockedUserDataSourceModel `tfsdk:"elements"`
+}
+
+func (d *UserBlockedUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_blocked_users"
+}
+
+func (d *UserBlockedUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"elements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of users blocked by the user the provider is authenticated as.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: blockedUserSchemaAttributes,
+				},
+			},
+		},
+		MarkdownDescription: "Use this data source to retrieve information about existing forgejo users blocked by the user the provider is authenticated as.",
+	}
+}
+
+func (d *UserBlockedUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserBlockedUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserBlockedUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blockedUsers, err := d.client.UserBlockedUsersList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("ListUserBlockedUsers", fmt.Sprintf("failed to list blocked users: %s", err))
+		return
+	}
+	data.Elements = populateBlockedUserDataSourceModels(blockedUsers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
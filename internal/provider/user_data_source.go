@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type UserDataSource struct {
+	client *client.Client
+}
+
+var _ datasource.DataSource = &UserDataSource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := maps.Clone(userDataSourceSchemaAttributes)
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The identifier of the user. Exactly one of `id` or `login` must be specified.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("login")),
+			},
+		},
+		"login": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The login of the user. Exactly one of `id` or `login` must be specified.",
+			Optional:            true,
+		},
+	})
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Use this data source to retrieve information about an existing forgejo user.",
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var user *client.User
+	var err error
+	if data.Login.IsNull() {
+		user, err = d.client.UserGetById(ctx, data.Id.ValueInt64())
+	} else {
+		user, err = d.client.UserGet(ctx, data.Login.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("UserGet", fmt.Sprintf("failed to get user: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, populateUserDataSourceModel(user))...)
+}
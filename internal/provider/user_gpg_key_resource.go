@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserGpgKeyResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &UserGpgKeyResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &UserGpgKeyResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserGpgKeyResource() resource.Resource {
+	return &UserGpgKeyResource{}
+}
+
+type UserGpgKeyResourceModel struct {
+	ArmoredPublicKey types.String                   `tfsdk:"armored_public_key"`
+	ArmoredSignature types.String                   `tfsdk:"armored_signature"`
+	CreatedAt        timetypes.RFC3339              `tfsdk:"created_at"`
+	Emails           []UserGpgKeyEmailResourceModel `tfsdk:"emails"`
+	ExpiresAt        timetypes.RFC3339              `tfsdk:"expires_at"`
+	Id               types.Int64                    `tfsdk:"id"`
+	KeyId            types.String                   `tfsdk:"key_id"`
+	Verified         types.Bool                     `tfsdk:"verified"`
+}
+
+type UserGpgKeyEmailResourceModel struct {
+	Email    types.String `tfsdk:"email"`
+	Verified types.Bool   `tfsdk:"verified"`
+}
+
+func (d *UserGpgKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_gpg_key"
+}
+
+func (d *UserGpgKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"armored_public_key": schema.StringAttribute{
+				MarkdownDescription: "The ASCII armored GPG public key. Since forgejo does not return it in this format, it is trusted to be correct after an import.",
+				PlanModifiers:       []planmodifier.String{requiresReplaceUnlessImported()},
+				Required:            true,
+				Validators:          []validator.String{gpgPublicKeyValidator{}},
+			},
+			"armored_signature": schema.StringAttribute{
+				MarkdownDescription: "An ASCII armored signature of the token given by forgejo's `/user/gpg_key_token` endpoint, used to verify the key when creating it.",
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The GPG key's creation date and time.",
+			},
+			"emails": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The list of email addresses of the GPG key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email address.",
+						},
+						"verified": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the email address is verified or not.",
+						},
+					},
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The GPG key's expiration date and time, null if it does not expire.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the GPG key.",
+			},
+			"key_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The GPG key's key ID.",
+			},
+			"verified": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the GPG key is verified or not.",
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a GPG key of the user the provider is authenticated as.",
+	}
+}
+
+func (d *UserGpgKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserGpgKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserGpgKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key, err := d.client.UserGpgKeyCreate(ctx, data.ArmoredPublicKey.ValueString(), data.ArmoredSignature.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserGpgKey", fmt.Sprintf("failed to create user gpg key: %s", err))
+		return
+	}
+	populateUserGpgKeyResourceModel(&data, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserGpgKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserGpgKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := d.client.UserGpgKeyDelete(ctx, data.Id.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("DeleteUserGpgKey", fmt.Sprintf("failed to delete user gpg key: %s", err))
+		return
+	}
+}
+
+func (r *UserGpgKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: gpgKeyId. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
+func populateUserGpgKeyResourceModel(data *UserGpgKeyResourceModel, key *client.UserGpgKey) {
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(key.CreatedAt)
+	data.Emails = make([]UserGpgKeyEmailResourceModel, len(key.Emails))
+	for i, email := range key.Emails {
+		data.Emails[i] = UserGpgKeyEmailResourceModel{
+			Email:    types.StringValue(email.Email),
+			Verified: types.BoolValue(email.Verified),
+		}
+	}
+	if key.ExpiresAt.IsZero() {
+		data.ExpiresAt = timetypes.NewRFC3339Null()
+	} else {
+		data.ExpiresAt = timetypes.NewRFC3339TimeValue(key.ExpiresAt)
+	}
+	data.Id = types.Int64Value(key.Id)
+	data.KeyId = types.StringValue(key.KeyId)
+	data.Verified = types.BoolValue(key.Verified)
+}
+
+func (d *UserGpgKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserGpgKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key, err := d.client.UserGpgKeyGet(ctx, data.Id.ValueInt64())
+	if client.IsStatusCode(err, http.StatusNotFound) {
+		// the key was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("ReadUserGpgKey", fmt.Sprintf("failed to get user gpg key: %s", err))
+		return
+	}
+	populateUserGpgKeyResourceModel(&data, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only reachable when setting the armored public key of an imported GPG key or
+// when changing the signature, which is only used on creation.
+func (d *UserGpgKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData UserGpgKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData UserGpgKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateData.ArmoredPublicKey = plannedData.ArmoredPublicKey
+	stateData.ArmoredSignature = plannedData.ArmoredSignature
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
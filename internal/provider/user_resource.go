@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &UserResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &UserResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResourceModel struct {
+	Active             types.Bool   `tfsdk:"active"`
+	Admin              types.Bool   `tfsdk:"admin"`
+	Email              types.String `tfsdk:"email"`
+	FullName           types.String `tfsdk:"full_name"`
+	Id                 types.Int64  `tfsdk:"id"`
+	Login              types.String `tfsdk:"login"`
+	LoginName          types.String `tfsdk:"login_name"`
+	MaxRepoCreation    types.Int64  `tfsdk:"max_repo_creation"`
+	MustChangePassword types.Bool   `tfsdk:"must_change_password"`
+	Password           types.String `tfsdk:"password"`
+	ProhibitLogin      types.Bool   `tfsdk:"prohibit_login"`
+	Purge              types.Bool   `tfsdk:"purge"`
+	Restricted         types.Bool   `tfsdk:"restricted"`
+	SendNotify         types.Bool   `tfsdk:"send_notify"`
+	SourceId           types.Int64  `tfsdk:"source_id"`
+	Visibility         types.String `tfsdk:"visibility"`
+}
+
+func (d *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the user is active or not. Defaults to `true`.",
+				Optional:            true,
+			},
+			"admin": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the user is an instance administrator or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The user's email address.",
+				Required:            true,
+			},
+			"full_name": schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The user's full name. Defaults to an empty string.",
+				Optional:            true,
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the user.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"login": schema.StringAttribute{
+				MarkdownDescription: "The user's login. Changing it renames the user.",
+				Required:            true,
+			},
+			"login_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user's login name on the authentication source identified by `source_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_repo_creation": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of repositories the user can create, `-1` meaning the instance's global limit applies. Since it cannot be read back from forgejo, it is left untouched when unset and changes made outside of terraform are not detected.",
+				Optional:            true,
+			},
+			"must_change_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user must change their password on their next login or not. Forgejo defaults to `true` when creating the user. Since it cannot be read back from forgejo, it is only sent when creating the user or when changing it, and changes made outside of terraform are not detected.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The user's initial password. Changing it afterwards resets the user's password, except on the first apply after an import which only records it. Since it cannot be read back from forgejo, changes made outside of terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"prohibit_login": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the user is prohibited from logging in or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"purge": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to purge the user's repositories, organizations memberships and other data when deleting it. Otherwise forgejo refuses to delete users that still own repositories or organizations. Defaults to `false`.",
+				Optional:            true,
+			},
+			"restricted": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the user is restricted or not. Restricted users can only access the repositories and organizations they are explicitly granted access to. Defaults to `false`.",
+				Optional:            true,
+			},
+			"send_notify": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to send a notification email to the user when creating it or not. Defaults to `false`.",
+				Optional:            true,
+			},
+			"source_id": schema.Int64Attribute{
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "The identifier of the authentication source of the user, for example an LDAP or OAuth source. Defaults to `0` which means a local account.",
+				Optional:            true,
+			},
+			"visibility": schema.StringAttribute{
+				Computed:            true,
+				Default:             stringdefault.StaticString("public"),
+				MarkdownDescription: "The user's visibility option: `limited`, `private`, `public`. Defaults to `public`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("limited", "private", "public"),
+				},
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a user. It requires the provider to be authenticated as an admin user.",
+	}
+}
+
+func (d *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request := client.AdminUserCreateRequest{
+		Email:              data.Email.ValueString(),
+		FullName:           data.FullName.ValueString(),
+		MustChangePassword: data.MustChangePassword.ValueBoolPointer(),
+		Password:           data.Password.ValueString(),
+		Restricted:         data.Restricted.ValueBool(),
+		SendNotify:         data.SendNotify.ValueBool(),
+		SourceId:           data.SourceId.ValueInt64(),
+		Username:           data.Login.ValueString(),
+		Visibility:         data.Visibility.ValueString(),
+	}
+	if !data.LoginName.IsUnknown() {
+		request.LoginName = data.LoginName.ValueString()
+	}
+	created, err := d.client.AdminUserCreate(ctx, &request)
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUser", fmt.Sprintf("failed to create user: %s", err))
+		return
+	}
+	// the user exists even if editing it fails, the next apply retries the edit
+	createdData := data
+	populateUserResourceModel(&createdData, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &createdData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// active, admin, max_repo_creation and prohibit_login can only be set by
+	// editing the user
+	user, err := d.client.AdminUserUpdate(ctx, data.Login.ValueString(), userUpdateRequest(&data, nil))
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUser", fmt.Sprintf("failed to update user after creating it: %s", err))
+		return
+	}
+	populateUserResourceModel(&data, user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := d.client.AdminUserDelete(ctx, data.Login.ValueString(), data.Purge.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("DeleteUser", fmt.Sprintf("failed to delete user: %s", err))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("login"), req.ID)...)
+}
+
+func populateUserResourceModel(data *UserResourceModel, user *client.User) {
+	data.Active = types.BoolValue(user.Active)
+	data.Admin = types.BoolValue(user.IsAdmin)
+	data.Email = types.StringValue(user.Email)
+	data.FullName = types.StringValue(user.FullName)
+	data.Id = types.Int64Value(user.Id)
+	data.Login = types.StringValue(user.Login)
+	data.LoginName = types.StringValue(user.LoginName)
+	data.ProhibitLogin = types.BoolValue(user.ProhibitLogin)
+	data.Restricted = types.BoolValue(user.Restricted)
+	data.SourceId = types.Int64Value(user.SourceId)
+	data.Visibility = types.StringValue(user.Visibility)
+}
+
+func (d *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	user, err := d.client.UserGet(ctx, data.Login.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadUser", fmt.Sprintf("failed to get user: %s", err))
+		return
+	}
+	populateUserResourceModel(&data, user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plannedData.Login.Equal(stateData.Login) {
+		err := d.client.AdminUserRename(ctx, stateData.Login.ValueString(), plannedData.Login.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("UpdateUser", fmt.Sprintf("failed to rename user: %s", err))
+			return
+		}
+	}
+	user, err := d.client.AdminUserUpdate(
+		ctx,
+		plannedData.Login.ValueString(),
+		userUpdateRequest(&plannedData, &stateData))
+	if err != nil {
+		resp.Diagnostics.AddError("UpdateUser", fmt.Sprintf("failed to update user: %s", err))
+		return
+	}
+	populateUserResourceModel(&plannedData, user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+}
+
+// forgejo requires the login name on edits, which is the login for local
+// accounts. The password and must_change_password are only sent when they
+// change, they are already set when creating the user whose state is nil.
+func userUpdateRequest(data *UserResourceModel, state *UserResourceModel) *client.AdminUserUpdateRequest {
+	request := client.AdminUserUpdateRequest{
+		Active:          data.Active.ValueBool(),
+		Admin:           data.Admin.ValueBool(),
+		Email:           data.Email.ValueString(),
+		FullName:        data.FullName.ValueString(),
+		LoginName:       data.LoginName.ValueString(),
+		MaxRepoCreation: data.MaxRepoCreation.ValueInt64Pointer(),
+		ProhibitLogin:   data.ProhibitLogin.ValueBool(),
+		Restricted:      data.Restricted.ValueBool(),
+		SourceId:        data.SourceId.ValueInt64(),
+		Visibility:      data.Visibility.ValueString(),
+	}
+	if data.LoginName.IsUnknown() || request.LoginName == "" {
+		request.LoginName = data.Login.ValueString()
+	}
+	if state != nil {
+		// after an import, the first apply only records must_change_password
+		// and the password
+		if !state.MustChangePassword.IsNull() && !data.MustChangePassword.Equal(state.MustChangePassword) {
+			request.MustChangePassword = data.MustChangePassword.ValueBoolPointer()
+		}
+		if !state.Password.IsNull() && !data.Password.Equal(state.Password) {
+			request.Password = data.Password.ValueString()
+		}
+	}
+	return &request
+}
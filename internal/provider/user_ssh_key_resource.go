@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"git.adyxax.org/adyxax/terraform-provider-forgejo/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UserSshKeyResource struct {
+	client *client.Client
+}
+
+var _ resource.Resource = &UserSshKeyResource{}                // Ensure provider defined types fully satisfy framework interfaces
+var _ resource.ResourceWithImportState = &UserSshKeyResource{} // Ensure provider defined types fully satisfy framework interfaces
+func NewUserSshKeyResource() resource.Resource {
+	return &UserSshKeyResource{}
+}
+
+type UserSshKeyResourceModel struct {
+	CreatedAt   timetypes.RFC3339 `tfsdk:"created_at"`
+	Fingerprint types.String      `tfsdk:"fingerprint"`
+	Id          types.Int64       `tfsdk:"id"`
+	Key         types.String      `tfsdk:"key"`
+	KeyType     types.String      `tfsdk:"key_type"`
+	Title       types.String      `tfsdk:"title"`
+	Username    types.String      `tfsdk:"username"`
+}
+
+func (d *UserSshKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_ssh_key"
+}
+
+func (d *UserSshKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "The SSH key's creation date and time.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SSH key's fingerprint.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the SSH key.",
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The SSH public key in the OpenSSH authorized_keys format.",
+				PlanModifiers:       []planmodifier.String{sshPublicKeyRequiresReplace()},
+				Required:            true,
+				Validators:          []validator.String{sshPublicKeyValidator{}},
+			},
+			"key_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SSH key's type.",
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The SSH key's title.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The login of the user the SSH key belongs to. Defaults to the user the provider is authenticated as. Managing the keys of other users requires an admin token.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Use this resource to create and manage a user SSH key.",
+	}
+}
+
+func (d *UserSshKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.client, _ = req.ProviderData.(*client.Client)
+}
+
+func (d *UserSshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserSshKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Username.IsUnknown() {
+		data.Username = types.StringValue(d.client.AuthenticatedUser())
+	}
+	var key *client.UserKey
+	var err error
+	if data.Username.ValueString() == d.client.AuthenticatedUser() {
+		key, err = d.client.UserKeyCreate(ctx, data.Title.ValueString(), data.Key.ValueString())
+	} else {
+		key, err = d.client.AdminUserKeyCreate(ctx, data.Username.ValueString(), data.Title.ValueString(), data.Key.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("CreateUserSshKey", fmt.Sprintf("failed to create user ssh key: %s", err))
+		return
+	}
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(key.CreatedAt)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+	data.Id = types.Int64Value(key.Id)
+	data.KeyType = types.StringValue(key.KeyType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserSshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserSshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var err error
+	if data.Username.ValueString() == d.client.AuthenticatedUser() {
+		err = d.client.UserKeyDelete(ctx, data.Id.ValueInt64())
+	} else {
+		err = d.client.AdminUserKeyDelete(ctx, data.Username.ValueString(), data.Id.ValueInt64())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("DeleteUserSshKey", fmt.Sprintf("failed to delete user ssh key: %s", err))
+		return
+	}
+}
+
+func (r *UserSshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	username := r.client.AuthenticatedUser()
+	if len(idParts) == 2 && idParts[0] != "" {
+		username = idParts[0]
+		idParts = idParts[1:]
+	}
+	id, err := strconv.ParseInt(idParts[0], 10, 64)
+	if len(idParts) != 1 || err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: sshKeyId or username/sshKeyId. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
+}
+
+func (d *UserSshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserSshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys, err := d.client.UserKeysList(ctx, data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ReadUserSshKey", fmt.Sprintf("failed to list user ssh keys: %s", err))
+		return
+	}
+	index := slices.IndexFunc(keys, func(key client.UserKey) bool {
+		return key.Id == data.Id.ValueInt64()
+	})
+	if index < 0 {
+		// the key was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	key := keys[index]
+	data.CreatedAt = timetypes.NewRFC3339TimeValue(key.CreatedAt)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+	// forgejo strips the key's comment
+	if sshPublicKeyWithoutComment(data.Key.ValueString()) != sshPublicKeyWithoutComment(key.Key) {
+		data.Key = types.StringValue(key.Key)
+	}
+	data.KeyType = types.StringValue(key.KeyType)
+	data.Title = types.StringValue(key.Title)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only reachable when changing the comment of the key, which forgejo strips.
+func (d *UserSshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plannedData UserSshKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plannedData)...)
+	var stateData UserSshKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateData.Key = plannedData.Key
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
@@ -22,6 +22,8 @@ func NewUsersDataSource() datasource.DataSource {
 
 type UsersDataSourceModel struct {
 	Elements []UserDataSourceModel `tfsdk:"elements"`
+	Query    types.String          `tfsdk:"query"`
+	Uid      types.Int64           `tfsdk:"uid"`
 }
 type UserDataSourceModel struct {
 	Active           types.Bool        `tfsdk:"active"`
@@ -160,6 +162,14 @@ func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 					Attributes: userDataSourceSchemaAttributes,
 				},
 			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only list users whose login or full name match this keyword.",
+				Optional:            true,
+			},
+			"uid": schema.Int64Attribute{
+				MarkdownDescription: "Only list the user with this identifier.",
+				Optional:            true,
+			},
 		},
 		MarkdownDescription: "Use this data source to retrieve information about existing forgejo users.",
 	}
@@ -203,7 +213,10 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	users, err := d.client.UsersList(ctx)
+	users, err := d.client.UsersList(ctx, &client.UsersSearchOptions{
+		Query: data.Query.ValueString(),
+		Uid:   data.Uid.ValueInt64Pointer(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("ListUsers", fmt.Sprintf("failed to list users: %s", err))
 		return
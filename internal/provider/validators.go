@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/crypto/ssh"
+)
+
+type avatarValidator struct {
+	file bool
+}
+
+var _ validator.String = avatarValidator{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (v avatarValidator) Description(ctx context.Context) string {
+	format := "a base64 encoded"
+	if v.file {
+		format = "the path to a local"
+	}
+	return fmt.Sprintf("value must be %s GIF, JPEG, PNG or WebP image of at most %d bytes", format, avatarMaxSize)
+}
+
+func (v avatarValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v avatarValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var image []byte
+	var err error
+	if v.file {
+		image, err = os.ReadFile(req.ConfigValue.ValueString())
+	} else {
+		image, err = base64.StdEncoding.DecodeString(req.ConfigValue.ValueString())
+	}
+	if err == nil {
+		err = validateAvatarImage(image)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Avatar",
+			fmt.Sprintf("Attribute %s %s. Validation failed with: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+type gpgPublicKeyValidator struct{}
+
+var _ validator.String = gpgPublicKeyValidator{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (v gpgPublicKeyValidator) Description(ctx context.Context) string {
+	return "value must be an ASCII armored GPG public key"
+}
+
+func (v gpgPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gpgPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := openpgp.ReadArmoredKeyRing(strings.NewReader(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid GPG Public Key",
+			fmt.Sprintf("Attribute %s %s. Parsing failed with: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+type sshPublicKeyValidator struct{}
+
+var _ validator.String = sshPublicKeyValidator{} // Ensure provider defined types fully satisfy framework interfaces
+
+func (v sshPublicKeyValidator) Description(ctx context.Context) string {
+	return "value must be a public key in the OpenSSH authorized_keys format"
+}
+
+func (v sshPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH Public Key",
+			fmt.Sprintf("Attribute %s %s, got: %q. Parsing failed with: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}